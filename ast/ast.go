@@ -2,11 +2,21 @@ package ast
 
 import (
 	"fmt"
+	"minicompiler/diag"
+	parseError "minicompiler/errors"
 	"minicompiler/token"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
+// NewProgram builds the program holding stmts, or an empty program when
+// stmts is nil.
 func NewProgram(stmts Attrib) (*Program, error) {
+	if stmts == nil {
+		return &Program{Statements: []Statement{}}, nil
+	}
+
 	s, ok := stmts.([]Statement)
 	if !ok {
 		return nil, fmt.Errorf("NewProgram []Statement stmts %v", stmts)
@@ -24,7 +34,8 @@ func AppendStatement(stmtList, stmt Attrib) ([]Statement, error) {
 	if !ok {
 		return nil, fmt.Errorf("AppendStatement Statement stmt %s", stmt)
 	}
-	return append(stmtList.([]Statement), s), nil
+	list, _ := stmtList.([]Statement)
+	return append(list, s), nil
 }
 
 func NewAssignStatement(left, right Attrib) (Statement, error) {
@@ -38,7 +49,7 @@ func NewAssignStatement(left, right Attrib) (Statement, error) {
 		return nil, fmt.Errorf("NewAssignStatement Identifier right %v", right)
 	}
 
-	return &AssignStatement{Left: Identifier{Value: string(l.Lit)}, Right: r}, nil
+	return &AssignStatement{Token: l, Left: Identifier{Token: l, Value: string(l.Lit)}, Right: r}, nil
 }
 
 func NewAssignTabStatement(left, index, right Attrib) (Statement, error) {
@@ -57,16 +68,57 @@ func NewAssignTabStatement(left, index, right Attrib) (Statement, error) {
 		return nil, fmt.Errorf("NewAssignTabStatement Expression right %v", right)
 	}
 
-	return &AssignTabStatement{Left: Identifier{Value: string(l.Lit)}, Right: r, Index: i}, nil
+	return &AssignTabStatement{Token: l, Left: Identifier{Token: l, Value: string(l.Lit)}, Right: r, Index: i}, nil
 }
 
-func NewBlockStatement(stmts Attrib) (*BlockStatement, error) {
+func NewBlockStatement(lbrace, stmts, rbrace Attrib) (*BlockStatement, error) {
+	l, ok := lbrace.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewBlockStatement *token.Token lbrace %v", lbrace)
+	}
+
 	s, ok := stmts.([]Statement)
 	if !ok {
 		return nil, fmt.Errorf("NewBlockStatement []Statement stmts %v", stmts)
 	}
 
-	return &BlockStatement{Statements: s}, nil
+	r, ok := rbrace.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewBlockStatement *token.Token rbrace %v", rbrace)
+	}
+
+	return &BlockStatement{Token: l, Statements: s, Rbrace: r}, nil
+}
+
+// NewRecoveredBlockStatement builds a block whose last statement holds a
+// syntax error, the parser skipped the tokens up to the closing brace.
+func NewRecoveredBlockStatement(lbrace, stmts, bad, rbrace Attrib) (*BlockStatement, error) {
+	s, ok := stmts.([]Statement)
+	if !ok {
+		return nil, fmt.Errorf("NewRecoveredBlockStatement []Statement stmts %v", stmts)
+	}
+
+	b, ok := bad.(*BadStatement)
+	if !ok {
+		return nil, fmt.Errorf("NewRecoveredBlockStatement *BadStatement bad %v", bad)
+	}
+
+	return NewBlockStatement(lbrace, append(s, b), rbrace)
+}
+
+// NewBadStatement records the syntax error the parser recovered from in its
+// context, which collects them when it is a *[]diag.Diagnostic.
+func NewBadStatement(ctx, err Attrib) (Statement, error) {
+	e, ok := err.(*parseError.Error)
+	if !ok {
+		return nil, fmt.Errorf("NewBadStatement *errors.Error err %v", err)
+	}
+
+	if diags, ok := ctx.(*[]diag.Diagnostic); ok {
+		*diags = append(*diags, diag.FromParseError(e))
+	}
+
+	return &BadStatement{Token: e.ErrorToken}, nil
 }
 
 func NewInfixExpression(left, right, oper Attrib) (Expression, error) {
@@ -89,45 +141,128 @@ func NewInfixExpression(left, right, oper Attrib) (Expression, error) {
 	return &InfixExpression{Left: l, Operator: string(o.Lit), Right: r, Token: o}, nil
 }
 
+func NewPrefixExpression(oper, right Attrib) (Expression, error) {
+	o, ok := oper.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewPrefixExpression *token.Token oper %v", oper)
+	}
+
+	r, ok := right.(Expression)
+	if !ok {
+		return nil, fmt.Errorf("NewPrefixExpression Expression right %v", right)
+	}
+
+	return &PrefixExpression{Token: o, Operator: string(o.Lit), Right: r}, nil
+}
+
 func NewIntegerLiteral(integer Attrib) (Expression, error) {
 	intLit, ok := integer.(*token.Token)
 	if !ok {
 		return nil, fmt.Errorf("NewIntegerLiteral *token.Token integer %v", integer)
 	}
 
+	if _, err := ParseInteger(string(intLit.Lit)); err != nil {
+		return nil, diag.Errorf(intLit, "invalid integer literal %s", intLit.Lit)
+	}
+
 	return &IntegerLiteral{Token: intLit, Value: string(intLit.Lit)}, nil
 }
 
-func NewIdentInit(ident, expr Attrib) (Statement, error) {
+// ParseInteger returns the value of an integer literal written in decimal
+// without leading zeros, in hexadecimal with a 0x prefix, in binary with a
+// 0b prefix or as a character between single quotes, which may be one of
+// the escapes \n, \r, \t, \0, \\ and \'. Any other form is rejected, as
+// the lexer never produces it.
+func ParseInteger(lit string) (int, error) {
+	if strings.HasPrefix(lit, "'") {
+		return parseChar(lit)
+	}
+
+	digits, base := lit, 10
+	switch {
+	case strings.HasPrefix(lit, "0x"), strings.HasPrefix(lit, "0X"):
+		digits, base = lit[2:], 16
+	case strings.HasPrefix(lit, "0b"), strings.HasPrefix(lit, "0B"):
+		digits, base = lit[2:], 2
+	case len(lit) > 1 && lit[0] == '0':
+		return 0, fmt.Errorf("invalid integer literal %q", lit)
+	}
+	// ParseUint takes no sign, and no underscore with a fixed base.
+	value, err := strconv.ParseUint(digits, base, 63)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer literal %q", lit)
+	}
+	return int(value), nil
+}
+
+var charEscapes = map[string]rune{
+	`\n`: '\n', `\r`: '\r', `\t`: '\t', `\0`: 0, `\\`: '\\', `\'`: '\'',
+}
+
+// parseChar returns the value of a character literal.
+func parseChar(lit string) (int, error) {
+	if len(lit) < 3 || !strings.HasSuffix(lit, "'") {
+		return 0, fmt.Errorf("invalid character literal %s", lit)
+	}
+	char := lit[1 : len(lit)-1]
+	if r, ok := charEscapes[char]; ok {
+		return int(r), nil
+	}
+	r, size := utf8.DecodeRuneInString(char)
+	if r == utf8.RuneError || size != len(char) || r == '\\' {
+		return 0, fmt.Errorf("invalid character literal %s", lit)
+	}
+	return int(r), nil
+}
+
+func NewIdentInit(at, ident, expr Attrib) (Statement, error) {
+	a, ok := at.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewIdentInit *token.Token at %v", at)
+	}
+
 	e, ok := expr.(Expression)
 	if !ok {
 		return nil, fmt.Errorf("NewIdentInit Expression expr %v", expr)
 	}
 
-	return &InitStatement{Location: string(ident.(*token.Token).Lit), Token: ident.(*token.Token), Expr: e}, nil
+	return &InitStatement{At: a, Location: string(ident.(*token.Token).Lit), Token: ident.(*token.Token), Expr: e}, nil
 }
 
-func NewTabInit(ident, size, defaultValue Attrib) (Statement, error) {
-	tabSize, err := strconv.Atoi(string(size.(*token.Token).Lit))
+func NewTabInit(at, ident, size, defaultValue Attrib) (Statement, error) {
+	a, ok := at.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewTabInit *token.Token at %v", at)
+	}
 
-	if err != nil {
+	s, ok := size.(Expression)
+	if !ok {
 		return nil, fmt.Errorf("NewTabInit size %v", size)
 	}
 
-	defaultVal, err := strconv.Atoi(string(defaultValue.(*token.Token).Lit))
-
-	if err != nil {
+	d, ok := defaultValue.(Expression)
+	if !ok {
 		return nil, fmt.Errorf("NewTabInit defaultValue %v", defaultValue)
 	}
 
-	return &TabInitStatement{Token: ident.(*token.Token), Location: string(ident.(*token.Token).Lit), Size: tabSize, DefaultValue: defaultVal}, nil
+	// literal sizes and default values are known right away, constant
+	// expressions are resolved by the semantic pass
+	tabSize, _ := literalValue(s)
+	defaultVal, _ := literalValue(d)
+
+	return &TabInitStatement{At: a, Token: ident.(*token.Token), Location: string(ident.(*token.Token).Lit), Size: tabSize, DefaultValue: defaultVal, SizeExpr: s, DefaultExpr: d}, nil
 }
 
 func NewIdentExpression(ident Attrib) (*Identifier, error) {
 	return &Identifier{Value: string(ident.(*token.Token).Lit), Token: ident.(*token.Token)}, nil
 }
 
-func NewIfStatement(cond, cons, alt Attrib) (Statement, error) {
+func NewIfStatement(tok, cond, cons, alt Attrib) (Statement, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("invalid type of tok. got=%T", tok)
+	}
+
 	c, ok := cond.(Expression)
 	if !ok {
 		return nil, fmt.Errorf("invalid type of cond. got=%T", cond)
@@ -143,14 +278,91 @@ func NewIfStatement(cond, cons, alt Attrib) (Statement, error) {
 		if a != nil {
 			return nil, fmt.Errorf("invalid type of alt. got=%T", alt)
 		}
-		list, _ := NewStatementList()
-		a, _ = NewBlockStatement(list)
+		// the missing else branch has no source, its block is empty
+		// and spans nothing
+		a = &BlockStatement{Statements: []Statement{}}
 	}
 
-	return &IfStatement{Condition: c, Block: cs, Alternative: a}, nil
+	return &IfStatement{Token: t, Condition: c, Block: cs, Alternative: a}, nil
 }
 
-func NewWhileStatement(cond, cons Attrib) (Statement, error) {
+func NewElseIfBlock(stmt Attrib) (*BlockStatement, error) {
+	s, ok := stmt.(Statement)
+	if !ok {
+		return nil, fmt.Errorf("NewElseIfBlock Statement stmt %v", stmt)
+	}
+
+	// the block has no braces in the source, it spans the if statement
+	return &BlockStatement{Statements: []Statement{s}}, nil
+}
+
+func NewMatchStatement(match, subject, arms, rbrace Attrib) (Statement, error) {
+	m, ok := match.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewMatchStatement *token.Token match %v", match)
+	}
+
+	s, ok := subject.(Expression)
+	if !ok {
+		return nil, fmt.Errorf("NewMatchStatement Expression subject %v", subject)
+	}
+
+	a, ok := arms.([]*MatchArm)
+	if !ok {
+		return nil, fmt.Errorf("NewMatchStatement []*MatchArm arms %v", arms)
+	}
+
+	r, ok := rbrace.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewMatchStatement *token.Token rbrace %v", rbrace)
+	}
+
+	return &MatchStatement{Token: m, Subject: s, Arms: a, Rbrace: r}, nil
+}
+
+func NewMatchArmList() ([]*MatchArm, error) {
+	return []*MatchArm{}, nil
+}
+
+func AppendMatchArm(armList, arm Attrib) ([]*MatchArm, error) {
+	a, ok := arm.(*MatchArm)
+	if !ok {
+		return nil, fmt.Errorf("AppendMatchArm *MatchArm arm %v", arm)
+	}
+	return append(armList.([]*MatchArm), a), nil
+}
+
+// NewMatchArm builds an arm of a match statement. A nil pattern is the
+// default arm written with _.
+func NewMatchArm(pattern, arrow, block Attrib) (*MatchArm, error) {
+	t, ok := arrow.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewMatchArm *token.Token arrow %v", arrow)
+	}
+
+	b, ok := block.(*BlockStatement)
+	if !ok {
+		return nil, fmt.Errorf("NewMatchArm *BlockStatement block %v", block)
+	}
+
+	if pattern == nil {
+		return &MatchArm{Token: t, Block: b}, nil
+	}
+
+	p, ok := pattern.(Expression)
+	if !ok {
+		return nil, fmt.Errorf("NewMatchArm Expression pattern %v", pattern)
+	}
+
+	return &MatchArm{Token: t, Pattern: p, Block: b}, nil
+}
+
+func NewWhileStatement(tok, cond, cons Attrib) (Statement, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("invalid type of tok. got=%T", tok)
+	}
+
 	c, ok := cond.(Expression)
 	if !ok {
 		return nil, fmt.Errorf("invalid type of cond. got=%T", cond)
@@ -161,30 +373,243 @@ func NewWhileStatement(cond, cons Attrib) (Statement, error) {
 		return nil, fmt.Errorf("invalid type of cons. got=%T", cons)
 	}
 
-	return &WhileStatement{Condition: c, Block: cs}, nil
+	return &WhileStatement{Token: t, Condition: c, Block: cs}, nil
 }
 
-func NewWaitStatement(time Attrib) (Statement, error) {
-	timeInt, err := strconv.Atoi(string(time.(*token.Token).Lit))
+func NewForStatement(tok, init, cond, post, block Attrib) (Statement, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewForStatement *token.Token tok %v", tok)
+	}
+
+	i, ok := init.(Statement)
+	if !ok {
+		return nil, fmt.Errorf("NewForStatement Statement init %v", init)
+	}
 
-	if err != nil {
-		return nil, fmt.Errorf("NewWaitStatement timeInt %v", timeInt)
+	c, ok := cond.(Expression)
+	if !ok {
+		return nil, fmt.Errorf("NewForStatement Expression cond %v", cond)
+	}
+
+	p, ok := post.(Statement)
+	if !ok {
+		return nil, fmt.Errorf("NewForStatement Statement post %v", post)
+	}
+
+	b, ok := block.(*BlockStatement)
+	if !ok {
+		return nil, fmt.Errorf("NewForStatement *BlockStatement block %v", block)
+	}
+
+	return &ForStatement{Token: t, Init: i, Condition: c, Post: p, Block: b}, nil
+}
+
+func NewBreakStatement(tok Attrib) (Statement, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewBreakStatement *token.Token tok %v", tok)
+	}
+
+	return &BreakStatement{Token: t}, nil
+}
+
+func NewContinueStatement(tok Attrib) (Statement, error) {
+	t, ok := tok.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewContinueStatement *token.Token tok %v", tok)
 	}
 
-	return &WaitStatement{Token: time.(*token.Token), Time: timeInt}, nil
+	return &ContinueStatement{Token: t}, nil
 }
 
-func NewTabExpression(ident, index Attrib) (Expression, error) {
+func NewWaitStatement(wait, time, rparen Attrib) (Statement, error) {
+	t, ok := time.(Expression)
+	if !ok {
+		return nil, fmt.Errorf("NewWaitStatement time %v", time)
+	}
+
+	r, ok := rparen.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewWaitStatement rparen %v", rparen)
+	}
+
+	timeInt, _ := literalValue(t)
+
+	return &WaitStatement{Token: wait.(*token.Token), Time: timeInt, TimeExpr: t, Rparen: r}, nil
+}
+
+func NewTabExpression(ident, index, rbrack Attrib) (Expression, error) {
 	identExpr, ok := ident.(*token.Token)
 	if !ok {
 		return nil, fmt.Errorf("NewTabExpression ident %v", ident)
 	}
-	
+
 	indexExpr, ok := index.(Expression)
 	if !ok {
 		return nil, fmt.Errorf("NewTabExpression index %v", index)
 	}
 
+	r, ok := rbrack.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewTabExpression rbrack %v", rbrack)
+	}
+
+	return &TabExpression{Token: identExpr, Ident: Identifier{Token: identExpr, Value: string(identExpr.Lit)}, Index: indexExpr, Rbrack: r}, nil
+}
+
+func NewProcStatement(keyword, name, block Attrib) (Statement, error) {
+	k, ok := keyword.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewProcStatement *token.Token keyword %v", keyword)
+	}
+
+	n, ok := name.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewProcStatement *token.Token name %v", name)
+	}
+
+	b, ok := block.(*BlockStatement)
+	if !ok {
+		return nil, fmt.Errorf("NewProcStatement *BlockStatement block %v", block)
+	}
+
+	return &ProcStatement{Keyword: k, Token: n, Name: string(n.Lit), Block: b}, nil
+}
+
+func NewFnStatement(keyword, name, params, block Attrib) (Statement, error) {
+	k, ok := keyword.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewFnStatement *token.Token keyword %v", keyword)
+	}
+
+	n, ok := name.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewFnStatement *token.Token name %v", name)
+	}
+
+	p, ok := params.([]Identifier)
+	if !ok {
+		return nil, fmt.Errorf("NewFnStatement []Identifier params %v", params)
+	}
+
+	b, ok := block.(*BlockStatement)
+	if !ok {
+		return nil, fmt.Errorf("NewFnStatement *BlockStatement block %v", block)
+	}
+
+	return &FnStatement{Keyword: k, Token: n, Name: string(n.Lit), Params: p, Block: b}, nil
+}
+
+func NewIdentifierList() ([]Identifier, error) {
+	return []Identifier{}, nil
+}
+
+func AppendIdentifier(identList, ident Attrib) ([]Identifier, error) {
+	i, ok := ident.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("AppendIdentifier *token.Token ident %v", ident)
+	}
+
+	list, _ := identList.([]Identifier)
+	return append(list, Identifier{Token: i, Value: string(i.Lit)}), nil
+}
+
+func NewExpressionList() ([]Expression, error) {
+	return []Expression{}, nil
+}
+
+func AppendExpression(exprList, expr Attrib) ([]Expression, error) {
+	e, ok := expr.(Expression)
+	if !ok {
+		return nil, fmt.Errorf("AppendExpression Expression expr %v", expr)
+	}
+
+	list, _ := exprList.([]Expression)
+	return append(list, e), nil
+}
+
+func NewCallStatement(name, args, rparen Attrib) (Statement, error) {
+	n, ok := name.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewCallStatement *token.Token name %v", name)
+	}
+
+	a, ok := args.([]Expression)
+	if !ok {
+		return nil, fmt.Errorf("NewCallStatement []Expression args %v", args)
+	}
+
+	r, ok := rparen.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewCallStatement *token.Token rparen %v", rparen)
+	}
+
+	return &CallStatement{Token: n, Name: string(n.Lit), Args: a, Rparen: r}, nil
+}
+
+func NewCallExpression(name, args, rparen Attrib) (Expression, error) {
+	n, ok := name.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewCallExpression *token.Token name %v", name)
+	}
+
+	a, ok := args.([]Expression)
+	if !ok {
+		return nil, fmt.Errorf("NewCallExpression []Expression args %v", args)
+	}
+
+	r, ok := rparen.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewCallExpression *token.Token rparen %v", rparen)
+	}
+
+	return &CallExpression{Token: n, Name: string(n.Lit), Args: a, Rparen: r}, nil
+}
+
+func NewReturnStatement(ret, value Attrib) (Statement, error) {
+	r, ok := ret.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewReturnStatement *token.Token ret %v", ret)
+	}
+
+	if value == nil {
+		return &ReturnStatement{Token: r}, nil
+	}
+
+	v, ok := value.(Expression)
+	if !ok {
+		return nil, fmt.Errorf("NewReturnStatement Expression value %v", value)
+	}
+
+	return &ReturnStatement{Token: r, Value: v}, nil
+}
+
+func NewConstStatement(keyword, name, value Attrib) (Statement, error) {
+	k, ok := keyword.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewConstStatement *token.Token keyword %v", keyword)
+	}
+
+	n, ok := name.(*token.Token)
+	if !ok {
+		return nil, fmt.Errorf("NewConstStatement *token.Token name %v", name)
+	}
+
+	v, ok := value.(Expression)
+	if !ok {
+		return nil, fmt.Errorf("NewConstStatement Expression value %v", value)
+	}
+
+	return &ConstStatement{Keyword: k, Token: n, Name: string(n.Lit), Value: v}, nil
+}
+
+func literalValue(expr Expression) (int, bool) {
+	lit, ok := expr.(*IntegerLiteral)
+	if !ok {
+		return 0, false
+	}
 
-	return &TabExpression{Token: ident.(*token.Token), Ident: Identifier{Value: string(identExpr.Lit)}, Index: indexExpr}, nil
+	value, err := ParseInteger(lit.Value)
+	return value, err == nil
 }
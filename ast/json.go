@@ -0,0 +1,494 @@
+package ast
+
+import (
+	"encoding/json"
+	"fmt"
+	"minicompiler/token"
+)
+
+// The JSON form of the syntax tree gives every node a "kind", the name of
+// its type, and its span as "pos" and "end", followed by the fields of the
+// node under their json tag. The names and the operators that do not start
+// their node have their position under a key of their own, such as
+// "namePos". Unmarshal reads it back, positions may then be left out.
+
+type position struct {
+	Offset int `json:"offset"`
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+type header struct {
+	Kind string   `json:"kind"`
+	Pos  position `json:"pos"`
+	End  position `json:"end"`
+}
+
+func newPosition(pos token.Pos) position {
+	return position{pos.Offset, pos.Line, pos.Column}
+}
+
+func newHeader(kind string, n Node) header {
+	return header{Kind: kind, Pos: newPosition(n.Pos()), End: newPosition(n.End())}
+}
+
+// marshalNode writes the header of a node followed by its fields. The
+// fields are given as a type without the MarshalJSON method of the node,
+// which would otherwise call itself.
+func marshalNode(h header, fields interface{}) ([]byte, error) {
+	head, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) == 2 {
+		return head, nil
+	}
+	return append(append(head[:len(head)-1], ','), body[1:]...), nil
+}
+
+func (p Program) MarshalJSON() ([]byte, error) {
+	type fields Program
+	return marshalNode(newHeader("Program", p), fields(p))
+}
+
+func (is InitStatement) MarshalJSON() ([]byte, error) {
+	type fields InitStatement
+	return marshalNode(newHeader("InitStatement", is), struct {
+		fields
+		LocationPos position `json:"locationPos"`
+	}{fields(is), newPosition(tokenPos(is.Token))})
+}
+
+func (oe TabInitStatement) MarshalJSON() ([]byte, error) {
+	type fields TabInitStatement
+	return marshalNode(newHeader("TabInitStatement", oe), struct {
+		fields
+		LocationPos position `json:"locationPos"`
+	}{fields(oe), newPosition(tokenPos(oe.Token))})
+}
+
+func (ls AssignStatement) MarshalJSON() ([]byte, error) {
+	type fields AssignStatement
+	return marshalNode(newHeader("AssignStatement", ls), fields(ls))
+}
+
+func (ls AssignTabStatement) MarshalJSON() ([]byte, error) {
+	type fields AssignTabStatement
+	return marshalNode(newHeader("AssignTabStatement", ls), fields(ls))
+}
+
+func (bs BadStatement) MarshalJSON() ([]byte, error) {
+	type fields BadStatement
+	return marshalNode(newHeader("BadStatement", bs), fields(bs))
+}
+
+func (bs BlockStatement) MarshalJSON() ([]byte, error) {
+	type fields BlockStatement
+	return marshalNode(newHeader("BlockStatement", bs), fields(bs))
+}
+
+// MarshalJSON writes the alternative as null when the else branch is
+// missing and as the if statement itself for an else if.
+func (is IfStatement) MarshalJSON() ([]byte, error) {
+	type fields IfStatement
+	var alt interface{} = is.Alternative
+	if a := is.Alternative; a != nil && a.Token == nil {
+		alt = nil
+		if len(a.Statements) > 0 {
+			alt = a.Statements[0]
+		}
+	}
+	return marshalNode(newHeader("IfStatement", is), struct {
+		fields
+		Alternative interface{} `json:"alternative"`
+	}{fields(is), alt})
+}
+
+func (ms MatchStatement) MarshalJSON() ([]byte, error) {
+	type fields MatchStatement
+	return marshalNode(newHeader("MatchStatement", ms), fields(ms))
+}
+
+// MarshalJSON spans the arm from its pattern, or the arrow of the default
+// arm, to the end of its block.
+func (ma MatchArm) MarshalJSON() ([]byte, error) {
+	type fields MatchArm
+	h := newHeader("MatchArm", ma.Block)
+	h.Pos = newPosition(tokenPos(ma.Token))
+	if ma.Pattern != nil {
+		h.Pos = newPosition(ma.Pattern.Pos())
+	}
+	return marshalNode(h, fields(ma))
+}
+
+func (is WhileStatement) MarshalJSON() ([]byte, error) {
+	type fields WhileStatement
+	return marshalNode(newHeader("WhileStatement", is), fields(is))
+}
+
+func (fs ForStatement) MarshalJSON() ([]byte, error) {
+	type fields ForStatement
+	return marshalNode(newHeader("ForStatement", fs), fields(fs))
+}
+
+func (bs BreakStatement) MarshalJSON() ([]byte, error) {
+	type fields BreakStatement
+	return marshalNode(newHeader("BreakStatement", bs), fields(bs))
+}
+
+func (cs ContinueStatement) MarshalJSON() ([]byte, error) {
+	type fields ContinueStatement
+	return marshalNode(newHeader("ContinueStatement", cs), fields(cs))
+}
+
+func (ws WaitStatement) MarshalJSON() ([]byte, error) {
+	type fields WaitStatement
+	return marshalNode(newHeader("WaitStatement", ws), fields(ws))
+}
+
+func (cs ConstStatement) MarshalJSON() ([]byte, error) {
+	type fields ConstStatement
+	return marshalNode(newHeader("ConstStatement", cs), struct {
+		fields
+		NamePos position `json:"namePos"`
+	}{fields(cs), newPosition(tokenPos(cs.Token))})
+}
+
+func (ps ProcStatement) MarshalJSON() ([]byte, error) {
+	type fields ProcStatement
+	return marshalNode(newHeader("ProcStatement", ps), struct {
+		fields
+		NamePos position `json:"namePos"`
+	}{fields(ps), newPosition(tokenPos(ps.Token))})
+}
+
+func (fs FnStatement) MarshalJSON() ([]byte, error) {
+	type fields FnStatement
+	return marshalNode(newHeader("FnStatement", fs), struct {
+		fields
+		NamePos position `json:"namePos"`
+	}{fields(fs), newPosition(tokenPos(fs.Token))})
+}
+
+func (cs CallStatement) MarshalJSON() ([]byte, error) {
+	type fields CallStatement
+	return marshalNode(newHeader("CallStatement", cs), fields(cs))
+}
+
+func (rs ReturnStatement) MarshalJSON() ([]byte, error) {
+	type fields ReturnStatement
+	return marshalNode(newHeader("ReturnStatement", rs), fields(rs))
+}
+
+func (i Identifier) MarshalJSON() ([]byte, error) {
+	type fields Identifier
+	return marshalNode(newHeader("Identifier", i), fields(i))
+}
+
+func (tab TabExpression) MarshalJSON() ([]byte, error) {
+	type fields TabExpression
+	return marshalNode(newHeader("TabExpression", tab), fields(tab))
+}
+
+func (il IntegerLiteral) MarshalJSON() ([]byte, error) {
+	type fields IntegerLiteral
+	return marshalNode(newHeader("IntegerLiteral", il), fields(il))
+}
+
+func (oe InfixExpression) MarshalJSON() ([]byte, error) {
+	type fields InfixExpression
+	return marshalNode(newHeader("InfixExpression", oe), struct {
+		fields
+		OperatorPos position `json:"operatorPos"`
+	}{fields(oe), newPosition(tokenPos(oe.Token))})
+}
+
+func (pe PrefixExpression) MarshalJSON() ([]byte, error) {
+	type fields PrefixExpression
+	return marshalNode(newHeader("PrefixExpression", pe), fields(pe))
+}
+
+func (ce CallExpression) MarshalJSON() ([]byte, error) {
+	type fields CallExpression
+	return marshalNode(newHeader("CallExpression", ce), fields(ce))
+}
+
+// Unmarshal rebuilds a program from its JSON form. The nodes are built by
+// the constructors the parser uses, from tokens placed at their recorded
+// position or else at the start of their node, so that the program compiles as the parsed one would.
+func Unmarshal(data []byte) (*Program, error) {
+	d := &decoder{}
+	n := d.node(data)
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	program, ok := n.(*Program)
+	if !ok {
+		return nil, fmt.Errorf("Unmarshal: want a Program, got %T", n)
+	}
+	return program, nil
+}
+
+// object is a JSON node whose fields are decoded on demand.
+type object struct {
+	kind   string
+	pos    token.Pos
+	end    token.Pos
+	fields map[string]json.RawMessage
+}
+
+// decoder keeps the first error met so that the nodes are built without
+// checking every field.
+type decoder struct {
+	err error
+}
+
+func (d *decoder) errorf(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf(format, args...)
+	}
+}
+
+// check keeps the error of a constructor and returns its node.
+func (d *decoder) check(n Attrib, err error) Attrib {
+	if err != nil {
+		d.errorf("%v", err)
+	}
+	return n
+}
+
+func (d *decoder) object(data json.RawMessage) *object {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		d.errorf("invalid node %s: %v", data, err)
+		return nil
+	}
+	if fields == nil {
+		return nil
+	}
+
+	o := &object{fields: fields}
+	o.kind = d.string(o, "kind")
+	var h header
+	if err := json.Unmarshal(data, &h); err != nil {
+		d.errorf("invalid position in %v: %v", o.kind, err)
+	}
+	o.pos = token.Pos{Offset: h.Pos.Offset, Line: h.Pos.Line, Column: h.Pos.Column}
+	o.end = token.Pos{Offset: h.End.Offset, Line: h.End.Line, Column: h.End.Column}
+	return o
+}
+
+func (d *decoder) string(o *object, key string) string {
+	var s string
+	if err := json.Unmarshal(o.fields[key], &s); err != nil {
+		d.errorf("invalid %v of %v: %v", key, o.kind, err)
+	}
+	return s
+}
+
+func (d *decoder) list(o *object, key string) []json.RawMessage {
+	var list []json.RawMessage
+	if err := json.Unmarshal(o.fields[key], &list); err != nil {
+		d.errorf("invalid %v of %v: %v", key, o.kind, err)
+	}
+	return list
+}
+
+// token returns a token of type id placed at the start of the node o.
+func (d *decoder) token(o *object, id, lit string) *token.Token {
+	return &token.Token{Type: token.TokMap.Type(id), Lit: []byte(lit), Pos: o.pos}
+}
+
+// tokenAt returns a token of type id placed at the position under key,
+// or at the start of the node o when there is none.
+func (d *decoder) tokenAt(o *object, key, id, lit string) *token.Token {
+	tok := d.token(o, id, lit)
+	if o.fields[key] == nil {
+		return tok
+	}
+	var p position
+	if err := json.Unmarshal(o.fields[key], &p); err != nil {
+		d.errorf("invalid %v of %v: %v", key, o.kind, err)
+	}
+	tok.Pos = token.Pos{Offset: p.Offset, Line: p.Line, Column: p.Column}
+	return tok
+}
+
+// closing returns the closing token of the node o, such as the brace of a
+// block, which ends the node.
+func (d *decoder) closing(o *object, id, lit string) *token.Token {
+	pos := o.end
+	if pos.Line != 0 {
+		pos.Offset--
+		pos.Column--
+	}
+	return &token.Token{Type: token.TokMap.Type(id), Lit: []byte(lit), Pos: pos}
+}
+
+// ident returns the token of the Identifier node under key.
+func (d *decoder) ident(o *object, key string) *token.Token {
+	i, ok := d.field(o, key).(*Identifier)
+	if !ok {
+		d.errorf("%v of %v is not an Identifier", key, o.kind)
+		return &token.Token{}
+	}
+	return i.Token
+}
+
+// field decodes the node under key, nil when it is null or missing.
+func (d *decoder) field(o *object, key string) Attrib {
+	if o.fields[key] == nil {
+		return nil
+	}
+	return d.node(o.fields[key])
+}
+
+func (d *decoder) statements(o *object, key string) []Statement {
+	stmts := []Statement{}
+	for _, data := range d.list(o, key) {
+		s, ok := d.node(data).(Statement)
+		if !ok {
+			d.errorf("%v of %v holds a node that is no statement", key, o.kind)
+			continue
+		}
+		stmts = append(stmts, s)
+	}
+	return stmts
+}
+
+func (d *decoder) expressions(o *object, key string) []Expression {
+	exprs := []Expression{}
+	for _, data := range d.list(o, key) {
+		e, ok := d.node(data).(Expression)
+		if !ok {
+			d.errorf("%v of %v holds a node that is no expression", key, o.kind)
+			continue
+		}
+		exprs = append(exprs, e)
+	}
+	return exprs
+}
+
+// infixOperators and prefixOperators map the operators of the language to
+// their token type.
+var infixOperators = map[string]string{
+	"||": "||",
+	"&&": "&&",
+	"==": "==",
+	"!=": "!=",
+	"<":  "<",
+	">":  ">",
+	"<=": "<=",
+	">=": ">=",
+	"&":  "and",
+	"+":  "plus",
+	"-":  "minus",
+	"*":  "mul",
+}
+
+var prefixOperators = map[string]string{
+	"!": "!",
+}
+
+// operator returns the token of the operator of o, which must be one of
+// operators.
+func (d *decoder) operator(o *object, operators map[string]string) *token.Token {
+	op := d.string(o, "operator")
+	id, ok := operators[op]
+	if !ok {
+		d.errorf("unknown operator %q in %v", op, o.kind)
+	}
+	return d.tokenAt(o, "operatorPos", id, op)
+}
+
+func (d *decoder) node(data json.RawMessage) Attrib {
+	o := d.object(data)
+	if o == nil || d.err != nil {
+		return nil
+	}
+
+	switch o.kind {
+	case "Program":
+		return d.check(NewProgram(d.statements(o, "statements")))
+	case "InitStatement":
+		return d.check(NewIdentInit(d.token(o, "@", "@"), d.tokenAt(o, "locationPos", "identifier", d.string(o, "location")), d.field(o, "expression")))
+	case "TabInitStatement":
+		return d.check(NewTabInit(d.token(o, "@", "@"), d.tokenAt(o, "locationPos", "identifier", d.string(o, "location")), d.field(o, "sizeExpr"), d.field(o, "defaultExpr")))
+	case "AssignStatement":
+		return d.check(NewAssignStatement(d.ident(o, "left"), d.field(o, "right")))
+	case "AssignTabStatement":
+		return d.check(NewAssignTabStatement(d.ident(o, "left"), d.field(o, "index"), d.field(o, "right")))
+	case "BadStatement":
+		return &BadStatement{Token: d.token(o, "error", "")}
+	case "BlockStatement":
+		return d.check(NewBlockStatement(d.token(o, "lbrace", "{"), d.statements(o, "statements"), d.closing(o, "rbrace", "}")))
+	case "IfStatement":
+		alt := d.field(o, "alternative")
+		if stmt, ok := alt.(*IfStatement); ok {
+			alt = d.check(NewElseIfBlock(stmt))
+		}
+		return d.check(NewIfStatement(d.token(o, "if", "if"), d.field(o, "condition"), d.field(o, "block"), alt))
+	case "MatchStatement":
+		arms := []*MatchArm{}
+		for _, data := range d.list(o, "arms") {
+			arm, ok := d.node(data).(*MatchArm)
+			if !ok {
+				d.errorf("arms of MatchStatement holds a node that is no MatchArm")
+				continue
+			}
+			arms = append(arms, arm)
+		}
+		return d.check(NewMatchStatement(d.token(o, "match", "match"), d.field(o, "subject"), arms, d.closing(o, "rbrace", "}")))
+	case "MatchArm":
+		return d.check(NewMatchArm(d.field(o, "pattern"), d.token(o, "=>", "=>"), d.field(o, "block")))
+	case "WhileStatement":
+		return d.check(NewWhileStatement(d.token(o, "while", "while"), d.field(o, "condition"), d.field(o, "block")))
+	case "ForStatement":
+		return d.check(NewForStatement(d.token(o, "for", "for"), d.field(o, "init"), d.field(o, "condition"), d.field(o, "post"), d.field(o, "block")))
+	case "BreakStatement":
+		return d.check(NewBreakStatement(d.token(o, "break", "break")))
+	case "ContinueStatement":
+		return d.check(NewContinueStatement(d.token(o, "continue", "continue")))
+	case "WaitStatement":
+		return d.check(NewWaitStatement(d.token(o, "wait", "wait"), d.field(o, "timeExpr"), d.closing(o, ")", ")")))
+	case "ConstStatement":
+		return d.check(NewConstStatement(d.token(o, "const", "const"), d.tokenAt(o, "namePos", "identifier", d.string(o, "name")), d.field(o, "value")))
+	case "ProcStatement":
+		return d.check(NewProcStatement(d.token(o, "proc", "proc"), d.tokenAt(o, "namePos", "identifier", d.string(o, "name")), d.field(o, "block")))
+	case "FnStatement":
+		params := []Identifier{}
+		for _, data := range d.list(o, "params") {
+			param, ok := d.node(data).(*Identifier)
+			if !ok {
+				d.errorf("params of FnStatement holds a node that is no Identifier")
+				continue
+			}
+			params = append(params, *param)
+		}
+		return d.check(NewFnStatement(d.token(o, "fn", "fn"), d.tokenAt(o, "namePos", "identifier", d.string(o, "name")), params, d.field(o, "block")))
+	case "CallStatement":
+		return d.check(NewCallStatement(d.token(o, "identifier", d.string(o, "name")), d.expressions(o, "args"), d.closing(o, ")", ")")))
+	case "ReturnStatement":
+		return d.check(NewReturnStatement(d.token(o, "return", "return"), d.field(o, "value")))
+	case "Identifier":
+		return d.check(NewIdentExpression(d.token(o, "identifier", d.string(o, "value"))))
+	case "TabExpression":
+		return d.check(NewTabExpression(d.ident(o, "ident"), d.field(o, "index"), d.closing(o, "]", "]")))
+	case "IntegerLiteral":
+		return d.check(NewIntegerLiteral(d.token(o, "intLit", d.string(o, "value"))))
+	case "InfixExpression":
+		return d.check(NewInfixExpression(d.field(o, "left"), d.field(o, "right"), d.operator(o, infixOperators)))
+	case "PrefixExpression":
+		return d.check(NewPrefixExpression(d.operator(o, prefixOperators), d.field(o, "right")))
+	case "CallExpression":
+		return d.check(NewCallExpression(d.token(o, "identifier", d.string(o, "name")), d.expressions(o, "args"), d.closing(o, ")", ")")))
+	}
+
+	d.errorf("unknown node kind %q", o.kind)
+	return nil
+}
@@ -1,11 +1,19 @@
 package ast
 
-import "minicompiler/token"
+import (
+	"minicompiler/diag"
+	"minicompiler/token"
+)
 
 type Attrib interface{}
 
+// Node is an element of the syntax tree. Pos is the position of its first
+// character and End the position of the character following it, both are
+// zero for the nodes the parser did not build from the source.
 type Node interface {
 	TokenLiteral() string
+	Pos() token.Pos
+	End() token.Pos
 }
 
 type Statement interface {
@@ -19,18 +27,34 @@ type Expression interface {
 }
 
 type Program struct {
-	Statements []Statement
+	Statements []Statement `json:"statements"`
 }
 
 func (p Program) TokenLiteral() string {
 	return "Program"
 }
 
+func (p Program) Pos() token.Pos {
+	if len(p.Statements) == 0 {
+		return token.Pos{}
+	}
+	return p.Statements[0].Pos()
+}
+
+func (p Program) End() token.Pos {
+	if len(p.Statements) == 0 {
+		return token.Pos{}
+	}
+	return p.Statements[len(p.Statements)-1].End()
+}
+
 
 type InitStatement struct {
+	At       *token.Token `json:"-"`
 	Token    *token.Token `json:"-"`
 	Expr     Expression   `json:"expression"`
 	Location string       `json:"location"`
+	Storage  string       `json:"-"`
 }
 
 func (is InitStatement) statementNode() {}
@@ -38,11 +62,23 @@ func (is InitStatement) TokenLiteral() string {
 	return "InitStatement"
 }
 
+func (is InitStatement) Pos() token.Pos {
+	return tokenPos(is.At)
+}
+
+func (is InitStatement) End() token.Pos {
+	return is.Expr.End()
+}
+
 type TabInitStatement struct {
+	At           *token.Token `json:"-"`
 	Token        *token.Token `json:"-"`
 	Size         int          `json:"size"`
 	DefaultValue int          `json:"defaultValue"`
+	SizeExpr     Expression   `json:"sizeExpr"`
+	DefaultExpr  Expression   `json:"defaultExpr"`
 	Location     string       `json:"location"`
+	Storage      string       `json:"-"`
 }
 
 func (oe TabInitStatement) statementNode() {}
@@ -50,6 +86,14 @@ func (oe TabInitStatement) TokenLiteral() string {
 	return "TabInitStatement"
 }
 
+func (oe TabInitStatement) Pos() token.Pos {
+	return tokenPos(oe.At)
+}
+
+func (oe TabInitStatement) End() token.Pos {
+	return oe.DefaultExpr.End()
+}
+
 type AssignStatement struct {
 	Token *token.Token `json:"-"`
 	Left  Identifier   `json:"left"`
@@ -61,6 +105,14 @@ func (ls AssignStatement) TokenLiteral() string {
 	return "AssignStatement"
 }
 
+func (ls AssignStatement) Pos() token.Pos {
+	return ls.Left.Pos()
+}
+
+func (ls AssignStatement) End() token.Pos {
+	return ls.Right.End()
+}
+
 type AssignTabStatement struct {
 	Token *token.Token `json:"-"`
 	Left  Identifier   `json:"left"`
@@ -73,9 +125,37 @@ func (ls AssignTabStatement) TokenLiteral() string {
 	return "AssignTabStatement"
 }
 
+func (ls AssignTabStatement) Pos() token.Pos {
+	return ls.Left.Pos()
+}
+
+func (ls AssignTabStatement) End() token.Pos {
+	return ls.Right.End()
+}
+
+// BadStatement stands for a statement holding a syntax error that the parser
+// recovered from, Token is the token at which the error was found.
+type BadStatement struct {
+	Token *token.Token `json:"-"`
+}
+
+func (bs BadStatement) statementNode() {}
+func (bs BadStatement) TokenLiteral() string {
+	return "BadStatement"
+}
+
+func (bs BadStatement) Pos() token.Pos {
+	return tokenPos(bs.Token)
+}
+
+func (bs BadStatement) End() token.Pos {
+	return tokenEnd(bs.Token)
+}
+
 type BlockStatement struct {
 	Token      *token.Token `json:"-"`
 	Statements []Statement  `json:"statements"`
+	Rbrace     *token.Token `json:"-"`
 }
 
 func (bs BlockStatement) statementNode() {}
@@ -83,6 +163,20 @@ func (bs BlockStatement) TokenLiteral() string {
 	return "BlockStatement"
 }
 
+func (bs BlockStatement) Pos() token.Pos {
+	if bs.Token == nil && len(bs.Statements) > 0 {
+		return bs.Statements[0].Pos()
+	}
+	return tokenPos(bs.Token)
+}
+
+func (bs BlockStatement) End() token.Pos {
+	if bs.Rbrace == nil && len(bs.Statements) > 0 {
+		return bs.Statements[len(bs.Statements)-1].End()
+	}
+	return tokenEnd(bs.Rbrace)
+}
+
 type IfStatement struct {
 	Token       *token.Token    `json:"-"`
 	Condition   Expression      `json:"condition"`
@@ -95,6 +189,45 @@ func (is IfStatement) TokenLiteral() string {
 	return "IfStatement"
 }
 
+func (is IfStatement) Pos() token.Pos {
+	return tokenPos(is.Token)
+}
+
+func (is IfStatement) End() token.Pos {
+	if is.Alternative != nil && is.Alternative.End().Line != 0 {
+		return is.Alternative.End()
+	}
+	return is.Block.End()
+}
+
+type MatchStatement struct {
+	Token   *token.Token `json:"-"`
+	Subject Expression   `json:"subject"`
+	Arms    []*MatchArm  `json:"arms"`
+	Rbrace  *token.Token `json:"-"`
+}
+
+func (ms MatchStatement) statementNode() {}
+func (ms MatchStatement) TokenLiteral() string {
+	return "MatchStatement"
+}
+
+func (ms MatchStatement) Pos() token.Pos {
+	return tokenPos(ms.Token)
+}
+
+func (ms MatchStatement) End() token.Pos {
+	return tokenEnd(ms.Rbrace)
+}
+
+// MatchArm is one pattern of a match statement, Pattern is nil for the
+// default arm.
+type MatchArm struct {
+	Token   *token.Token    `json:"-"`
+	Pattern Expression      `json:"pattern"`
+	Block   *BlockStatement `json:"block"`
+}
+
 type WhileStatement struct {
 	Token     *token.Token    `json:"-"`
 	Condition Expression      `json:"condition"`
@@ -106,9 +239,74 @@ func (is WhileStatement) TokenLiteral() string {
 	return "WhileStatement"
 }
 
-type WaitStatement struct {
+func (is WhileStatement) Pos() token.Pos {
+	return tokenPos(is.Token)
+}
+
+func (is WhileStatement) End() token.Pos {
+	return is.Block.End()
+}
+
+type ForStatement struct {
+	Token     *token.Token    `json:"-"`
+	Init      Statement       `json:"init"`
+	Condition Expression      `json:"condition"`
+	Post      Statement       `json:"post"`
+	Block     *BlockStatement `json:"block"`
+}
+
+func (fs ForStatement) statementNode() {}
+func (fs ForStatement) TokenLiteral() string {
+	return "ForStatement"
+}
+
+func (fs ForStatement) Pos() token.Pos {
+	return tokenPos(fs.Token)
+}
+
+func (fs ForStatement) End() token.Pos {
+	return fs.Block.End()
+}
+
+type BreakStatement struct {
 	Token *token.Token `json:"-"`
-	Time  int          `json:"time"`
+}
+
+func (bs BreakStatement) statementNode() {}
+func (bs BreakStatement) TokenLiteral() string {
+	return "BreakStatement"
+}
+
+func (bs BreakStatement) Pos() token.Pos {
+	return tokenPos(bs.Token)
+}
+
+func (bs BreakStatement) End() token.Pos {
+	return tokenEnd(bs.Token)
+}
+
+type ContinueStatement struct {
+	Token *token.Token `json:"-"`
+}
+
+func (cs ContinueStatement) statementNode() {}
+func (cs ContinueStatement) TokenLiteral() string {
+	return "ContinueStatement"
+}
+
+func (cs ContinueStatement) Pos() token.Pos {
+	return tokenPos(cs.Token)
+}
+
+func (cs ContinueStatement) End() token.Pos {
+	return tokenEnd(cs.Token)
+}
+
+type WaitStatement struct {
+	Token    *token.Token `json:"-"`
+	Time     int          `json:"time"`
+	TimeExpr Expression   `json:"timeExpr"`
+	Rparen   *token.Token `json:"-"`
 }
 
 func (ws WaitStatement) statementNode() {}
@@ -116,25 +314,156 @@ func (ws WaitStatement) TokenLiteral() string {
 	return "WaitStatement"
 }
 
-type Identifier struct {
+func (ws WaitStatement) Pos() token.Pos {
+	return tokenPos(ws.Token)
+}
+
+func (ws WaitStatement) End() token.Pos {
+	return tokenEnd(ws.Rparen)
+}
+
+type ConstStatement struct {
+	Keyword *token.Token `json:"-"`
+	Token   *token.Token `json:"-"`
+	Name    string       `json:"name"`
+	Value   Expression   `json:"value"`
+}
+
+func (cs ConstStatement) statementNode() {}
+func (cs ConstStatement) TokenLiteral() string {
+	return "ConstStatement"
+}
+
+func (cs ConstStatement) Pos() token.Pos {
+	return tokenPos(cs.Keyword)
+}
+
+func (cs ConstStatement) End() token.Pos {
+	return cs.Value.End()
+}
+
+type ProcStatement struct {
+	Keyword *token.Token    `json:"-"`
+	Token   *token.Token    `json:"-"`
+	Name    string          `json:"name"`
+	Block   *BlockStatement `json:"block"`
+}
+
+func (ps ProcStatement) statementNode() {}
+func (ps ProcStatement) TokenLiteral() string {
+	return "ProcStatement"
+}
+
+func (ps ProcStatement) Pos() token.Pos {
+	return tokenPos(ps.Keyword)
+}
+
+func (ps ProcStatement) End() token.Pos {
+	return ps.Block.End()
+}
+
+type FnStatement struct {
+	Keyword *token.Token    `json:"-"`
+	Token   *token.Token    `json:"-"`
+	Name    string          `json:"name"`
+	Params  []Identifier    `json:"params"`
+	Block   *BlockStatement `json:"block"`
+}
+
+func (fs FnStatement) statementNode() {}
+func (fs FnStatement) TokenLiteral() string {
+	return "FnStatement"
+}
+
+func (fs FnStatement) Pos() token.Pos {
+	return tokenPos(fs.Keyword)
+}
+
+func (fs FnStatement) End() token.Pos {
+	return fs.Block.End()
+}
+
+type CallStatement struct {
+	Token  *token.Token `json:"-"`
+	Name   string       `json:"name"`
+	Args   []Expression `json:"args"`
+	Rparen *token.Token `json:"-"`
+}
+
+func (cs CallStatement) statementNode() {}
+func (cs CallStatement) TokenLiteral() string {
+	return "CallStatement"
+}
+
+func (cs CallStatement) Pos() token.Pos {
+	return tokenPos(cs.Token)
+}
+
+func (cs CallStatement) End() token.Pos {
+	return tokenEnd(cs.Rparen)
+}
+
+type ReturnStatement struct {
 	Token *token.Token `json:"-"`
-	Value string       `json:"value"`
+	Value Expression   `json:"value"`
+}
+
+func (rs ReturnStatement) statementNode() {}
+func (rs ReturnStatement) TokenLiteral() string {
+	return "ReturnStatement"
+}
+
+func (rs ReturnStatement) Pos() token.Pos {
+	return tokenPos(rs.Token)
+}
+
+func (rs ReturnStatement) End() token.Pos {
+	if rs.Value != nil {
+		return rs.Value.End()
+	}
+	return tokenEnd(rs.Token)
+}
+
+// Identifier is a name used in the source. Storage is the data label of
+// the variable, table or parameter it refers to, as resolved by the
+// semantic pass.
+type Identifier struct {
+	Token   *token.Token `json:"-"`
+	Value   string       `json:"value"`
+	Storage string       `json:"-"`
 }
 
 func (i Identifier) expressionNode() {}
 func (i Identifier) TokenLiteral() string {
-	return string(i.Token.Lit)
+	return tokenLiteral(i.Token, i.Value)
+}
+
+func (i Identifier) Pos() token.Pos {
+	return tokenPos(i.Token)
+}
+
+func (i Identifier) End() token.Pos {
+	return tokenEnd(i.Token)
 }
 
 type TabExpression struct {
-	Token *token.Token `json:"-"`
-	Index Expression `json:"index"`
-	Ident Identifier `json:"indent"`
+	Token  *token.Token `json:"-"`
+	Index  Expression   `json:"index"`
+	Ident  Identifier   `json:"ident"`
+	Rbrack *token.Token `json:"-"`
 }
 
 func (tab TabExpression) expressionNode() {}
 func (tab TabExpression) TokenLiteral() string {
-	return string(tab.Token.Lit)
+	return tokenLiteral(tab.Token, tab.Ident.Value)
+}
+
+func (tab TabExpression) Pos() token.Pos {
+	return tokenPos(tab.Token)
+}
+
+func (tab TabExpression) End() token.Pos {
+	return tokenEnd(tab.Rbrack)
 }
 
 type IntegerLiteral struct {
@@ -144,7 +473,15 @@ type IntegerLiteral struct {
 
 func (il IntegerLiteral) expressionNode() {}
 func (il IntegerLiteral) TokenLiteral() string {
-	return string(il.Token.Lit)
+	return tokenLiteral(il.Token, il.Value)
+}
+
+func (il IntegerLiteral) Pos() token.Pos {
+	return tokenPos(il.Token)
+}
+
+func (il IntegerLiteral) End() token.Pos {
+	return tokenEnd(il.Token)
 }
 
 type InfixExpression struct {
@@ -157,5 +494,73 @@ type InfixExpression struct {
 
 func (oe InfixExpression) expressionNode() {}
 func (oe InfixExpression) TokenLiteral() string {
-	return string(oe.Token.Lit)
+	return tokenLiteral(oe.Token, oe.Operator)
+}
+
+func (oe InfixExpression) Pos() token.Pos {
+	return oe.Left.Pos()
+}
+
+func (oe InfixExpression) End() token.Pos {
+	return oe.Right.End()
+}
+
+type PrefixExpression struct {
+	Token    *token.Token `json:"-"`
+	Operator string       `json:"operator"`
+	Right    Expression   `json:"right"`
+}
+
+func (pe PrefixExpression) expressionNode() {}
+func (pe PrefixExpression) TokenLiteral() string {
+	return tokenLiteral(pe.Token, pe.Operator)
+}
+
+func (pe PrefixExpression) Pos() token.Pos {
+	return tokenPos(pe.Token)
+}
+
+func (pe PrefixExpression) End() token.Pos {
+	return pe.Right.End()
+}
+
+type CallExpression struct {
+	Token  *token.Token `json:"-"`
+	Name   string       `json:"name"`
+	Args   []Expression `json:"args"`
+	Rparen *token.Token `json:"-"`
+}
+
+func (ce CallExpression) expressionNode() {}
+func (ce CallExpression) TokenLiteral() string {
+	return tokenLiteral(ce.Token, ce.Name)
+}
+
+func (ce CallExpression) Pos() token.Pos {
+	return tokenPos(ce.Token)
+}
+
+func (ce CallExpression) End() token.Pos {
+	return tokenEnd(ce.Rparen)
+}
+
+// tokenLiteral returns the source text of tok, or text for the nodes built
+// without a token, such as those read by Unmarshal.
+func tokenLiteral(tok *token.Token, text string) string {
+	if tok == nil {
+		return text
+	}
+	return string(tok.Lit)
+}
+
+func tokenPos(tok *token.Token) token.Pos {
+	if tok == nil {
+		return token.Pos{}
+	}
+	return tok.Pos
+}
+
+func tokenEnd(tok *token.Token) token.Pos {
+	_, end := diag.Span(tok)
+	return end
 }
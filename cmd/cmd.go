@@ -3,30 +3,242 @@ package cmd
 import (
 	"errors"
 	"flag"
+	"fmt"
+	"io"
 	"os"
-	"regexp"
+	"path/filepath"
+	"strconv"
+	"strings"
 )
 
-type CompileOptions struct {
-	Inputpath      string
-	Outputpath     string
-	AssemblyOutput bool
+// Stdio is the path standing for the standard input or output.
+const Stdio = "-"
+
+// Emit values, the default is EmitAsm.
+const (
+	EmitTokens = "tokens"
+	EmitAst    = "ast"
+	EmitIR     = "ir"
+	EmitAsm    = "asm"
+	EmitMif    = "mif"
+	EmitBin    = "bin"
+)
+
+var emitExt = map[string]string{
+	EmitTokens: ".tokens",
+	EmitAst:    ".json",
+	EmitIR:     ".ir",
+	EmitAsm:    ".asm",
+	EmitMif:    ".mif",
+	EmitBin:    ".bin",
+}
+
+// Options holds the command to run and the flags of every command, only
+// those of Command are set.
+type Options struct {
+	Command   string
+	Inputpath string
+	// Outputpath receives the output selected by Emit, MifOutputpath the
+	// memory image when it is not empty. Both may be Stdio.
+	Outputpath    string
+	MifOutputpath string
+	Emit          string
+	Quiet         bool
+	Verbose       bool
+
+	// run
+	MaxSteps int
+	Input    []uint8
+	Seed     int64
+	Screen   bool
+
+	// fmt
+	Write bool
+}
+
+type command struct {
+	name    string
+	summary string
+	// flags declares the flags of the command and resolve checks them
+	// once parsed and fills in the defaults depending on the input.
+	flags   func(flags *flag.FlagSet, opts *Options)
+	resolve func(opts *Options) error
+}
+
+var commands = []command{
+	{"build", "compile to assembly and memory image", buildFlags, resolveBuild},
+	{"check", "parse and analyze only", noFlags, noResolve},
+	{"run", "compile and execute in the simulator", runFlags, noResolve},
+	{"fmt", "format the source", fmtFlags, resolveFmt},
+	{"disasm", "turn a memory image back into assembly", disasmFlags, noResolve},
+}
+
+const usage = `usage: minicompiler [command] [flags] file
+
+The file is read from the standard input when it is -. Without a command,
+the file is built.
+
+commands:
+`
+
+// GetOptions parses the command line. A missing command stands for build,
+// so that minicompiler file.minic keeps working.
+func GetOptions() (Options, error) {
+	args := os.Args[1:]
+	if len(args) > 0 && (args[0] == "-h" || args[0] == "-help" || args[0] == "--help" || args[0] == "help") {
+		printUsage()
+		return Options{}, flag.ErrHelp
+	}
+
+	cmd := commands[0]
+	if len(args) > 0 {
+		for _, c := range commands {
+			if args[0] == c.name {
+				cmd = c
+				args = args[1:]
+				break
+			}
+		}
+	}
+
+	opts := Options{Command: cmd.name}
+	flags := flag.NewFlagSet("minicompiler "+cmd.name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: minicompiler %v [flags] file\n\n%v.\n\nflags:\n", cmd.name, strings.ToUpper(cmd.summary[:1])+cmd.summary[1:])
+		flags.SetOutput(os.Stderr)
+		flags.PrintDefaults()
+		flags.SetOutput(io.Discard)
+	}
+	flags.BoolVar(&opts.Quiet, "q", false, "only print errors")
+	flags.BoolVar(&opts.Quiet, "quiet", false, "same as -q")
+	flags.BoolVar(&opts.Verbose, "v", false, "print what is done")
+	flags.BoolVar(&opts.Verbose, "verbose", false, "same as -v")
+	cmd.flags(flags, &opts)
+
+	args, err := parse(flags, args)
+	if err != nil {
+		return Options{}, err
+	}
+	if len(args) == 0 {
+		return Options{}, errors.New("you must specify the path of the input file")
+	}
+	if len(args) > 1 {
+		return Options{}, fmt.Errorf("too many input files: %v", strings.Join(args, " "))
+	}
+	opts.Inputpath = args[0]
+
+	if opts.Quiet && opts.Verbose {
+		return Options{}, errors.New("--quiet and --verbose cannot be used together")
+	}
+	if err := cmd.resolve(&opts); err != nil {
+		return Options{}, err
+	}
+
+	return opts, nil
 }
 
-func GetCompileOptions() (CompileOptions, error) {
-	if len(os.Args) < 2 {
-		return CompileOptions{}, errors.New("you must specify the path of the input file")
+func printUsage() {
+	fmt.Fprint(os.Stderr, usage)
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8v%v\n", c.name, c.summary)
 	}
-	flag.Parse()
+	fmt.Fprint(os.Stderr, "\nRun minicompiler <command> -h for the flags of a command.\n")
+}
+
+func noFlags(flags *flag.FlagSet, opts *Options) {}
 
-	inputPath := flag.Arg(0)
-	outputPath := "" 
+func noResolve(opts *Options) error {
+	return nil
+}
 
-	if len(outputPath) == 0 {
-		reg := regexp.MustCompile(`\..*?$`)
-		outputPath = reg.ReplaceAllString(inputPath, ".asm")
+func buildFlags(flags *flag.FlagSet, opts *Options) {
+	flags.StringVar(&opts.Outputpath, "o", "", "write the output to `path`, - for the standard output")
+	flags.StringVar(&opts.MifOutputpath, "mif-out", "", "also write the memory image to `path`, next to the input by default when -o is not given")
+	flags.StringVar(&opts.Emit, "emit", EmitAsm, "output `kind`: tokens, ast, ir, asm, mif or bin")
+}
+
+func resolveBuild(opts *Options) error {
+	ext, ok := emitExt[opts.Emit]
+	if !ok {
+		return fmt.Errorf("unknown --emit value %q, want tokens, ast, ir, asm, mif or bin", opts.Emit)
+	}
+	if opts.MifOutputpath != "" && (opts.Emit == EmitTokens || opts.Emit == EmitAst || opts.Emit == EmitIR) {
+		return fmt.Errorf("--mif-out cannot be used with --emit=%v", opts.Emit)
 	}
 
-	return CompileOptions{inputPath, outputPath, true}, nil
+	// The dumps and the compilation of the standard input are written to
+	// the standard output, the assembly of a file next to it together with
+	// its memory image. With -o only the memory image asked with --mif-out
+	// is written.
+	if opts.Outputpath == "" {
+		switch {
+		case opts.Inputpath == Stdio || opts.Emit == EmitTokens || opts.Emit == EmitAst || opts.Emit == EmitIR:
+			opts.Outputpath = Stdio
+		default:
+			opts.Outputpath = ReplaceExt(opts.Inputpath, ext)
+			if opts.MifOutputpath == "" && opts.Emit == EmitAsm {
+				opts.MifOutputpath = ReplaceExt(opts.Inputpath, emitExt[EmitMif])
+			}
+		}
+	}
+	return nil
+}
+
+func runFlags(flags *flag.FlagSet, opts *Options) {
+	flags.IntVar(&opts.MaxSteps, "max-steps", 10000000, "stop a program still running after `n` instructions")
+	flags.Func("input", "comma separated `bytes` read in turn at the input address, 0 once all read", func(s string) error {
+		for _, field := range strings.Split(s, ",") {
+			value, err := strconv.ParseUint(strings.TrimSpace(field), 0, 8)
+			if err != nil {
+				return fmt.Errorf("invalid input byte %q", field)
+			}
+			opts.Input = append(opts.Input, uint8(value))
+		}
+		return nil
+	})
+	flags.Int64Var(&opts.Seed, "seed", 1, "`seed` of the values read at the random address")
+	flags.BoolVar(&opts.Screen, "screen", false, "print the screen once the program halts")
+}
+
+func fmtFlags(flags *flag.FlagSet, opts *Options) {
+	flags.BoolVar(&opts.Write, "w", false, "write the result to the file instead of the standard output")
+}
+
+func resolveFmt(opts *Options) error {
+	if opts.Write && opts.Inputpath == Stdio {
+		return errors.New("-w cannot be used with the standard input")
+	}
+	opts.Outputpath = Stdio
+	if opts.Write {
+		opts.Outputpath = opts.Inputpath
+	}
+	return nil
+}
+
+func disasmFlags(flags *flag.FlagSet, opts *Options) {
+	flags.StringVar(&opts.Outputpath, "o", Stdio, "write the assembly to `path`")
+}
+
+// parse parses the flags of args, which unlike flag.Parse may follow the
+// positional arguments, and returns those.
+func parse(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
 
+// ReplaceExt returns path with its extension replaced by ext, only the base
+// name is considered so that ./snake.minic gives ./snake.asm.
+func ReplaceExt(path, ext string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ext
 }
@@ -0,0 +1,94 @@
+// Package compiler runs the whole pipeline, from the source of a program to
+// the memory image of the board, for programs embedding the compiler.
+package compiler
+
+import (
+	"minicompiler/ast"
+	"minicompiler/diag"
+	parseError "minicompiler/errors"
+	"minicompiler/gen"
+	"minicompiler/ir"
+	"minicompiler/lexer"
+	"minicompiler/mif_parser"
+	"minicompiler/parser"
+	"minicompiler/semantic"
+)
+
+// Options changes how far Compile runs the pipeline.
+type Options struct {
+	// CheckOnly stops the compilation after the semantic analysis, the
+	// result then holds neither assembly nor MIF.
+	CheckOnly bool
+}
+
+// Result holds the output of every stage of a compilation. IR is the
+// program lowered from the syntax tree once folded, Asm the assembly
+// selected from it, Mif the memory image of the board and Symbols the
+// address in that image of every label and data label of the assembly.
+type Result struct {
+	Program *ast.Program
+	IR      *ir.Program
+	Asm     string
+	Mif     string
+	Symbols map[string]int
+}
+
+// Compile runs the whole pipeline on src and returns its result together
+// with the diagnostics of every stage. The compilation stops after the
+// first stage reporting an error, the fields of the later stages are then
+// left empty. Compile holds no global state and may be called from several
+// goroutines at once.
+func Compile(src []byte, opts Options) (*Result, []diag.Diagnostic) {
+	program, diags := Parse(src)
+	if diag.HasErrors(diags) {
+		return &Result{}, diags
+	}
+
+	result, progDiags := CompileProgram(program, opts)
+	return result, append(diags, progDiags...)
+}
+
+// CompileProgram runs the pipeline from the semantic analysis on, for a
+// program built without source such as one read by ast.Unmarshal.
+func CompileProgram(program *ast.Program, opts Options) (*Result, []diag.Diagnostic) {
+	result := &Result{Program: program}
+
+	diags := semantic.Check(program)
+	if diag.HasErrors(diags) || opts.CheckOnly {
+		return result, diags
+	}
+
+	irProgram := ir.Lower(program)
+	ir.Fold(irProgram)
+	result.IR = irProgram
+
+	asm := gen.NewGenerator().Generate(irProgram)
+	result.Asm = asm.String()
+
+	mif, mifDiags := mif_parser.CompileToMif(result.Asm)
+	diags = append(diags, mifDiags...)
+	if diag.HasErrors(diags) {
+		return result, diags
+	}
+	result.Mif = mif.String()
+	result.Symbols = mif_parser.Symbols(result.Asm)
+
+	return result, diags
+}
+
+// Parse returns the syntax tree of src. The parser recovers from the syntax
+// errors it can, so that a single run reports every malformed statement,
+// and a nil program is returned when it cannot.
+func Parse(src []byte) (*ast.Program, []diag.Diagnostic) {
+	l := lexer.NewLexer(src)
+	p := parser.NewParser()
+	var diags []diag.Diagnostic
+	p.Context = &diags
+	node, err := p.Parse(l)
+	if err != nil {
+		return nil, append(diags, diag.FromParseError(err.(*parseError.Error)))
+	}
+	program, _ := node.(*ast.Program)
+
+	return program, diags
+}
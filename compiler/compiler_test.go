@@ -0,0 +1,136 @@
+package compiler
+
+import (
+	"encoding/json"
+	"minicompiler/ast"
+	"minicompiler/diag"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentCompile compiles the example programs from several
+// goroutines at once, which go test -race checks for shared state.
+func TestConcurrentCompile(t *testing.T) {
+	const goroutines = 8
+	files := []string{"../snake.minic", "../space.minic"}
+
+	sources := map[string][]byte{}
+	want := map[string]*Result{}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, diags := Compile(src, Options{})
+		if diag.HasErrors(diags) {
+			t.Fatalf("%v: %v", file, diags)
+		}
+		sources[file], want[file] = src, result
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		for _, file := range files {
+			wg.Add(1)
+			go func(file string) {
+				defer wg.Done()
+				got, diags := Compile(sources[file], Options{})
+				if diag.HasErrors(diags) {
+					t.Errorf("%v: %v", file, diags)
+					return
+				}
+				w := want[file]
+				if got.Asm != w.Asm || got.Mif != w.Mif || !reflect.DeepEqual(got.Symbols, w.Symbols) {
+					t.Errorf("%v compiles differently in a goroutine than alone", file)
+				}
+			}(file)
+		}
+	}
+	wg.Wait()
+}
+
+// TestSyntaxErrorRecovery checks that every malformed statement is reported
+// once, at its own line, including those whose error is in the header of a
+// block.
+func TestSyntaxErrorRecovery(t *testing.T) {
+	lines := []string{
+		"@ x = 1;",
+		"if x == { x = 2; }",
+		"x = 3 +;",
+		"fn f(a { return a; }",
+		"while x < { x = 1; }",
+		"x = 4 *;",
+		"match x + { 1 => { x = 2; } }",
+		"proc 1 { x = 1; }",
+		"for x = 0; x <; x = x + 1 { x = 1; }",
+		"if x == 1 { } else if x == { x = 1; } else { x = 2; }",
+		"x = ;",
+	}
+	_, diags := Parse([]byte(strings.Join(lines, "\n")))
+
+	var got []int
+	for _, d := range diags {
+		got = append(got, d.Pos.Line)
+	}
+	want := []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("errors at lines %v, want %v: %v", got, want, diags)
+	}
+}
+
+// TestAstRoundTrip compiles the example programs from their syntax tree
+// dumped as JSON and read back, which must give the same assembly as the
+// source.
+func TestAstRoundTrip(t *testing.T) {
+	for _, file := range []string{"../snake.minic", "../space.minic"} {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, diags := Compile(src, Options{})
+		if diag.HasErrors(diags) {
+			t.Fatalf("%v: %v", file, diags)
+		}
+
+		program, _ := Parse(src)
+		data, err := json.Marshal(program)
+		if err != nil {
+			t.Fatalf("%v: %v", file, err)
+		}
+		reloaded, err := ast.Unmarshal(data)
+		if err != nil {
+			t.Fatalf("%v: %v", file, err)
+		}
+		got, diags := CompileProgram(reloaded, Options{})
+		if diag.HasErrors(diags) {
+			t.Fatalf("%v reloaded: %v", file, diags)
+		}
+		if got.Asm != want.Asm {
+			t.Errorf("%v compiles differently once reloaded", file)
+		}
+	}
+}
+
+func TestUnmarshalRejectsUnknownOperators(t *testing.T) {
+	program, diags := Parse([]byte("@ a = 1; @ b = !a; @ c = a + b;"))
+	if diag.HasErrors(diags) {
+		t.Fatal(diags)
+	}
+	data, err := json.Marshal(program)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, op := range []string{`"!"`, `"+"`} {
+		bad := strings.Replace(string(data), `"operator":`+op, `"operator":"%"`, 1)
+		if bad == string(data) {
+			t.Fatalf("no operator %v in %s", op, data)
+		}
+		if _, err := ast.Unmarshal([]byte(bad)); err == nil {
+			t.Errorf("Unmarshal accepts the operator %% in place of %v", op)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package diag
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	parseError "minicompiler/errors"
+	"minicompiler/token"
+)
+
+type Severity int
+
+const (
+	Error Severity = iota
+	Warning
+	Note
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Error:
+		return "error"
+	case Warning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// Diagnostic is a message about the source, located by the span going from
+// Pos to End. A zero Pos means the diagnostic is not tied to a location.
+type Diagnostic struct {
+	Severity Severity
+	Pos      token.Pos
+	End      token.Pos
+	Msg      string
+}
+
+func (d Diagnostic) Error() string {
+	if d.Pos.Line == 0 {
+		return fmt.Sprintf("%v: %v", d.Severity, d.Msg)
+	}
+	return fmt.Sprintf("%d:%d: %v: %v", d.Pos.Line, d.Pos.Column, d.Severity, d.Msg)
+}
+
+// Errorf returns an error diagnostic spanning the token tok.
+func Errorf(tok *token.Token, format string, args ...interface{}) Diagnostic {
+	pos, end := Span(tok)
+	return Diagnostic{Severity: Error, Pos: pos, End: end, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Node is anything that spans a part of the source, such as the nodes of
+// the syntax tree.
+type Node interface {
+	Pos() token.Pos
+	End() token.Pos
+}
+
+// NodeErrorf returns an error diagnostic spanning n.
+func NodeErrorf(n Node, format string, args ...interface{}) Diagnostic {
+	return Diagnostic{Severity: Error, Pos: n.Pos(), End: n.End(), Msg: fmt.Sprintf(format, args...)}
+}
+
+// Span returns the positions of the first character of tok and of the
+// character following it, or zero positions when tok is nil.
+func Span(tok *token.Token) (token.Pos, token.Pos) {
+	if tok == nil {
+		return token.Pos{}, token.Pos{}
+	}
+	end := tok.Pos
+	end.Offset += len(tok.Lit)
+	end.Column += utf8.RuneCount(tok.Lit)
+	return tok.Pos, end
+}
+
+// FromParseError converts an error of the lexer or the parser. A semantic
+// action of the grammar may fail with a Diagnostic, which is returned as it
+// is since it points at the offending token rather than at the lookahead.
+//
+// The expected tokens are not reported: once the parser has tried to recover
+// they are those of the state it went back to, not those of the state the
+// error was found in.
+func FromParseError(err *parseError.Error) Diagnostic {
+	var d Diagnostic
+	if errors.As(err.Err, &d) {
+		return d
+	}
+
+	pos, end := Span(err.ErrorToken)
+	d = Diagnostic{Severity: Error, Pos: pos, End: end}
+
+	switch {
+	case err.Err != nil:
+		d.Msg = err.Err.Error()
+	case err.ErrorToken.Type == token.INVALID:
+		d.Msg = fmt.Sprintf("invalid token %q", err.ErrorToken.Lit)
+	default:
+		d.Msg = fmt.Sprintf("syntax error: unexpected %v", parseError.DescribeToken(err.ErrorToken))
+	}
+	return d
+}
+
+// HasErrors reports whether diags holds a diagnostic of severity Error.
+func HasErrors(diags []Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == Error {
+			return true
+		}
+	}
+	return false
+}
+
+// Sort orders diags by position, keeping the order of diagnostics at the
+// same position.
+func Sort(diags []Diagnostic) {
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diags[i].Pos.Offset < diags[j].Pos.Offset
+	})
+}
+
+// Print writes diags to w in the style of gcc: the location prefixed by
+// filename, then the source line holding the diagnostic and a caret under
+// its span. Only the locations are written when src is nil.
+func Print(w io.Writer, filename string, src []byte, diags []Diagnostic) {
+	for _, d := range diags {
+		if d.Pos.Line == 0 {
+			fmt.Fprintf(w, "%v: %v\n", filename, d)
+			continue
+		}
+		fmt.Fprintf(w, "%v:%v\n", filename, d)
+		if src == nil {
+			continue
+		}
+
+		line := sourceLine(src, d.Pos.Offset)
+		fmt.Fprintf(w, "%v\n", line)
+		fmt.Fprintf(w, "%v\n", caret(line, d))
+	}
+}
+
+// sourceLine returns the line of src holding offset, without its newline.
+func sourceLine(src []byte, offset int) string {
+	if offset > len(src) {
+		offset = len(src)
+	}
+	start := strings.LastIndexByte(string(src[:offset]), '\n') + 1
+	end := strings.IndexByte(string(src[offset:]), '\n')
+	if end < 0 {
+		end = len(src)
+	} else {
+		end += offset
+	}
+	return strings.TrimRight(string(src[start:end]), "\r")
+}
+
+// caret returns the marker line of d under line, tabs are kept so that the
+// marker stays aligned whatever the tab width.
+func caret(line string, d Diagnostic) string {
+	var b strings.Builder
+	column := 1
+	for _, r := range line {
+		if column >= d.Pos.Column {
+			break
+		}
+		if r == '\t' {
+			// the lexer counts a tab as 4 columns
+			b.WriteRune('\t')
+			column += 4
+		} else {
+			b.WriteRune(' ')
+			column++
+		}
+	}
+	b.WriteRune('^')
+
+	width := d.End.Column - d.Pos.Column
+	if d.End.Line != d.Pos.Line {
+		width = utf8.RuneCountInString(line) - d.Pos.Column + 1
+	}
+	for i := 1; i < width; i++ {
+		b.WriteRune('~')
+	}
+	return b.String()
+}
@@ -0,0 +1,337 @@
+// Package format prints programs back as source in a canonical layout:
+// one statement per line, blocks indented with tabs, spaces around binary
+// operators and only the parentheses the precedence requires. Comments
+// and single blank lines between statements are kept.
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"minicompiler/ast"
+	"minicompiler/compiler"
+	"minicompiler/diag"
+	"strings"
+)
+
+// comment is a comment of the source, the lexer drops them so they are
+// found by scanning the source again.
+type comment struct {
+	Offset int
+	Line   int
+	Text   string
+}
+
+type printer struct {
+	b        bytes.Buffer
+	comments []comment
+	indent   int
+	// lastLine is the source line where the last element printed ends,
+	// it tells whether a blank line preceded the next one.
+	lastLine int
+}
+
+// Source formats the program src. The source is left as is when it holds
+// syntax errors, which are returned.
+func Source(src []byte) ([]byte, []diag.Diagnostic) {
+	program, diags := compiler.Parse(src)
+	if diag.HasErrors(diags) {
+		return nil, diags
+	}
+
+	return printProgram(program, scanComments(src), len(src)), diags
+}
+
+// Program formats a program built without source, such as one read by
+// ast.Unmarshal.
+func Program(program *ast.Program) []byte {
+	return printProgram(program, nil, 0)
+}
+
+func printProgram(program *ast.Program, comments []comment, end int) []byte {
+	p := &printer{comments: comments}
+	p.statements(program.Statements, end)
+	if p.b.Len() > 0 {
+		p.b.WriteString("\n")
+	}
+	return p.b.Bytes()
+}
+
+// scanComments returns the comments of src in order. It skips the character
+// literals so that '/' does not start a comment.
+func scanComments(src []byte) []comment {
+	var comments []comment
+	line := 1
+	for i := 0; i < len(src); i++ {
+		switch {
+		case src[i] == '\n':
+			line++
+		case src[i] == '\'':
+			if i+1 < len(src) && src[i+1] == '\\' {
+				i += 3
+			} else {
+				i += 2
+			}
+		case bytes.HasPrefix(src[i:], []byte("//")):
+			end := bytes.IndexByte(src[i:], '\n')
+			if end < 0 {
+				end = len(src) - i
+			}
+			comments = append(comments, comment{i, line, strings.TrimRight(string(src[i:i+end]), " \t\r")})
+			i += end - 1
+		case bytes.HasPrefix(src[i:], []byte("/*")):
+			end := bytes.Index(src[i+2:], []byte("*/"))
+			if end < 0 {
+				end = len(src) - i - 4
+			}
+			text := string(src[i : i+end+4])
+			comments = append(comments, comment{i, line, text})
+			line += strings.Count(text, "\n")
+			i += end + 3
+		}
+	}
+	return comments
+}
+
+func (p *printer) write(format string, args ...interface{}) {
+	fmt.Fprintf(&p.b, format, args...)
+}
+
+func (p *printer) newline() {
+	p.b.WriteString("\n" + strings.Repeat("\t", p.indent))
+}
+
+// line starts a line for an element beginning at source line, keeping one
+// blank line when there were some in the source.
+func (p *printer) line(line int) {
+	if p.b.Len() == 0 {
+		p.lastLine = line
+		p.b.WriteString(strings.Repeat("\t", p.indent))
+		return
+	}
+	if p.lastLine != 0 && line > p.lastLine+1 {
+		p.b.WriteString("\n")
+	}
+	p.newline()
+	p.lastLine = line
+}
+
+// commentsBefore prints on their own lines the comments placed before the
+// source offset.
+func (p *printer) commentsBefore(offset int) {
+	for len(p.comments) > 0 && p.comments[0].Offset < offset {
+		c := p.comments[0]
+		p.comments = p.comments[1:]
+		p.line(c.Line)
+		p.write("%v", c.Text)
+		p.lastLine = c.Line + strings.Count(c.Text, "\n")
+	}
+}
+
+// trailingComments prints after the statement n the comments inside it and
+// those following it on its last line.
+func (p *printer) trailingComments(n ast.Node) {
+	end := n.End()
+	for len(p.comments) > 0 && (p.comments[0].Offset < end.Offset || p.comments[0].Line == end.Line) {
+		c := p.comments[0]
+		p.comments = p.comments[1:]
+		p.write(" %v", c.Text)
+		if strings.HasPrefix(c.Text, "//") && len(p.comments) > 0 && p.comments[0].Line == end.Line {
+			p.newline()
+		}
+	}
+	if end.Line > p.lastLine {
+		p.lastLine = end.Line
+	}
+}
+
+// statements prints a list of statements followed by the comments placed
+// before the source offset end.
+func (p *printer) statements(stmts []ast.Statement, end int) {
+	for _, s := range stmts {
+		p.commentsBefore(s.Pos().Offset)
+		p.line(s.Pos().Line)
+		p.statement(s)
+		p.trailingComments(s)
+	}
+	p.commentsBefore(end)
+}
+
+func (p *printer) block(b *ast.BlockStatement) {
+	p.write("{")
+	p.lastLine = b.Pos().Line
+	end := b.End()
+	if len(b.Statements) == 0 && (len(p.comments) == 0 || p.comments[0].Offset >= end.Offset) {
+		p.write("}")
+		return
+	}
+
+	p.indent++
+	p.statements(b.Statements, end.Offset)
+	p.indent--
+	p.newline()
+	p.write("}")
+	p.lastLine = end.Line
+}
+
+func (p *printer) statement(s ast.Statement) {
+	switch node := s.(type) {
+	case *ast.IfStatement:
+		p.write("if %v ", expression(node.Condition, 0))
+		p.block(node.Block)
+		p.elseBlock(node.Alternative)
+	case *ast.MatchStatement:
+		p.write("match %v {", expression(node.Subject, 0))
+		p.lastLine = node.Subject.End().Line
+		p.indent++
+		for _, arm := range node.Arms {
+			pos := arm.Token.Pos
+			pattern := "_"
+			if arm.Pattern != nil {
+				pos = arm.Pattern.Pos()
+				pattern = expression(arm.Pattern, 0)
+			}
+			p.commentsBefore(pos.Offset)
+			p.line(pos.Line)
+			p.write("%v => ", pattern)
+			p.block(arm.Block)
+		}
+		p.commentsBefore(node.Rbrace.Offset)
+		p.indent--
+		p.newline()
+		p.write("}")
+	case *ast.WhileStatement:
+		p.write("while %v ", expression(node.Condition, 0))
+		p.block(node.Block)
+	case *ast.ForStatement:
+		p.write("for %v; %v; %v ", simpleStatement(node.Init), expression(node.Condition, 0), simpleStatement(node.Post))
+		p.block(node.Block)
+	case *ast.ProcStatement:
+		p.write("proc %v ", node.Name)
+		p.block(node.Block)
+	case *ast.FnStatement:
+		params := make([]string, len(node.Params))
+		for i, param := range node.Params {
+			params[i] = param.Value
+		}
+		p.write("fn %v(%v) ", node.Name, strings.Join(params, ", "))
+		p.block(node.Block)
+	case *ast.BlockStatement:
+		p.block(node)
+	case *ast.TabInitStatement:
+		p.write("@%v[%v] = %v;", node.Location, expression(node.SizeExpr, 0), expression(node.DefaultExpr, 0))
+	case *ast.WaitStatement:
+		p.write("wait(%v);", expression(node.TimeExpr, 0))
+	case *ast.BreakStatement:
+		p.write("break;")
+	case *ast.ContinueStatement:
+		p.write("continue;")
+	case *ast.CallStatement:
+		p.write("%v(%v);", node.Name, expressions(node.Args))
+	case *ast.ReturnStatement:
+		if node.Value == nil {
+			p.write("return;")
+		} else {
+			p.write("return %v;", expression(node.Value, 0))
+		}
+	case *ast.ConstStatement:
+		p.write("const %v = %v;", node.Name, expression(node.Value, 0))
+	default:
+		p.write("%v;", simpleStatement(s))
+	}
+}
+
+// elseBlock prints the else branch of an if statement, nothing when it is
+// missing and an else if when it is a block without braces.
+func (p *printer) elseBlock(alt *ast.BlockStatement) {
+	switch {
+	case alt == nil || alt.Token == nil && len(alt.Statements) == 0:
+	case alt.Token == nil:
+		p.write(" else ")
+		p.statement(alt.Statements[0])
+	default:
+		p.write(" else ")
+		p.block(alt)
+	}
+}
+
+// simpleStatement returns a statement allowed in the header of a for loop,
+// without its terminator.
+func simpleStatement(s ast.Statement) string {
+	switch node := s.(type) {
+	case *ast.InitStatement:
+		return fmt.Sprintf("@%v = %v", node.Location, expression(node.Expr, 0))
+	case *ast.AssignStatement:
+		return fmt.Sprintf("%v = %v", node.Left.Value, expression(node.Right, 0))
+	case *ast.AssignTabStatement:
+		return fmt.Sprintf("%v[%v] = %v", node.Left.Value, expression(node.Index, 0), expression(node.Right, 0))
+	}
+	return fmt.Sprintf("/* %T */", s)
+}
+
+// precedence returns the binding strength of an operator, following the
+// levels of the grammar from || to *.
+func precedence(operator string) int {
+	switch operator {
+	case "||":
+		return 1
+	case "&&":
+		return 2
+	case "!":
+		return 3
+	case "==", "!=", "<", ">", "<=", ">=":
+		return 4
+	case "&":
+		return 5
+	case "+", "-":
+		return 6
+	case "*":
+		return 7
+	}
+	return 8
+}
+
+// expression returns the source of an expression used where an operator
+// of precedence min at least is expected, parenthesized otherwise.
+func expression(e ast.Expression, min int) string {
+	var s string
+	prec := 8
+	switch node := e.(type) {
+	case *ast.InfixExpression:
+		// the operators are left associative
+		prec = precedence(node.Operator)
+		s = fmt.Sprintf("%v %v %v", expression(node.Left, prec), node.Operator, expression(node.Right, prec+1))
+	case *ast.PrefixExpression:
+		prec = precedence(node.Operator)
+		s = node.Operator + expression(node.Right, prec)
+	case *ast.IntegerLiteral:
+		s = literal(node)
+	case *ast.Identifier:
+		s = node.Value
+	case *ast.TabExpression:
+		s = fmt.Sprintf("%v[%v]", node.Ident.Value, expression(node.Index, 0))
+	case *ast.CallExpression:
+		s = fmt.Sprintf("%v(%v)", node.Name, expressions(node.Args))
+	}
+
+	if prec < min {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+func expressions(exprs []ast.Expression) string {
+	args := make([]string, len(exprs))
+	for i, e := range exprs {
+		args[i] = expression(e, 0)
+	}
+	return strings.Join(args, ", ")
+}
+
+// literal keeps the way an integer literal is written in the source, 0x1F
+// or 'a' rather than its decimal value.
+func literal(lit *ast.IntegerLiteral) string {
+	if lit.Token != nil {
+		return string(lit.Token.Lit)
+	}
+	return lit.Value
+}
@@ -3,276 +3,533 @@ package gen
 import (
 	"bytes"
 	"fmt"
-	"minicompiler/ast"
-	"strconv"
+	"minicompiler/ir"
 	"strings"
 )
 
-var tmpCount int
-var labelCount int
+// Generator selects the instructions of the CPU for a program in the ir
+// form. The code is written to b while the temporaries it needs are
+// collected in bTempVar, which is appended after the code with the data of
+// the program. Constants are immediate operands and need no data, and the
+// values are kept in R0 and R3 as long as they are not needed for another
+// one. A Generator holds the state of a single compilation, several
+// generators can run concurrently.
+type Generator struct {
+	b, bTempVar bytes.Buffer
+
+	tmpCount   int
+	labelCount int
+
+	// temps maps the ir temporaries to their data label, callSites the
+	// functions to the numbers of the labels their calls return to.
+	temps     map[ir.Temp]string
+	callSites map[*ir.Func][]int
+	// slots holds the data labels of the temporaries of the function being
+	// generated, live the interval of each of them. Temporaries whose
+	// intervals do not overlap share a slot.
+	slots []*slot
+	live  map[ir.Temp]ir.Interval
+	// targets holds the blocks of the function being generated that a
+	// terminator branches to, the others need no label.
+	targets map[*ir.Block]bool
+
+	// regs holds the value R0 and R3 are known to hold, nil when unknown.
+	// A local temporary, read only in the block computing it, is kept in R0
+	// without being stored. It is spilled to its slot when R0 is needed for
+	// another value while unread counts reads of it still to come.
+	regs    map[string]ir.Value
+	local   map[ir.Temp]bool
+	unread  map[ir.Temp]int
+	spilled map[ir.Temp]bool
+}
 
-var operatorToInstru = map[string]string{
-	"+":  "ADD",
-	"-":  "SUB",
-	"*":  "MUL",
-	"&":  "AND",
-	"==": "BEQ",
-	"!=":  "BNE",
-	"<":  "BCC",
-}
-
-func check(err error) {
-	if err != nil {
-		panic(err.Error())
+// slot is a data byte and the intervals of the temporaries stored in it.
+type slot struct {
+	name      string
+	intervals []ir.Interval
+}
+
+func NewGenerator() *Generator {
+	return &Generator{
+		temps:     map[ir.Temp]string{},
+		callSites: map[*ir.Func][]int{},
+		regs:      map[string]ir.Value{},
 	}
 }
 
+var operatorToInstru = map[string]string{
+	"+": "ADD",
+	"-": "SUB",
+	"*": "MUL",
+	"&": "AND",
+}
+
+// comparison describes how a comparison operator is selected on a CPU that
+// only branches on equal, not equal and carry clear (unsigned lower).
+// swap compares the right operand against the left one and the condition
+// holds when any of the branches is taken.
+type comparison struct {
+	swap     bool
+	branches []string
+}
+
+var comparisons = map[string]comparison{
+	"==": {branches: []string{"BEQ"}},
+	"!=": {branches: []string{"BNE"}},
+	"<":  {branches: []string{"BCC"}},
+	">":  {swap: true, branches: []string{"BCC"}},
+	"<=": {branches: []string{"BCC", "BEQ"}},
+	">=": {swap: true, branches: []string{"BCC", "BEQ"}},
+}
+
+// commutative holds the operators whose operands can be swapped to use the
+// registers that already hold them.
+var commutative = map[string]bool{
+	"+":  true,
+	"*":  true,
+	"&":  true,
+	"==": true,
+	"!=": true,
+}
+
+var negatedComparisons = map[string]string{
+	"==": "!=",
+	"!=": "==",
+	"<":  ">=",
+	">=": "<",
+	">":  "<=",
+	"<=": ">",
+}
+
 func write(b *bytes.Buffer, code string, args ...interface{}) {
 	b.WriteString(fmt.Sprintf(code, args...))
 }
 
-func GenWrapper(p *ast.Program) bytes.Buffer {
-	tmpCount = 0
-	labelCount = 0
-	var b, bVar, bTempVar, bTabs bytes.Buffer
-	gen(p, &b, &bVar, &bTempVar, &bTabs)
-
-	b.WriteString("endprog\nB endprog\n\n")
+// Generate returns the assembly of p. A Generator is meant for one program,
+// use a new one for the next.
+func (g *Generator) Generate(p *ir.Program) bytes.Buffer {
+	g.genFunc(p.Main)
+	g.b.WriteString("endprog\nB endprog\n\n")
 
-	b.WriteString("const_1 DCB 0x1\n")
-	b.WriteString("const_0 DCB 0x0\n")
-	b.WriteString(bTempVar.String())
-	b.WriteString(bTabs.String())
-	b.WriteString(bVar.String())
+	for _, f := range p.Funcs {
+		write(&g.b, "proc%v\n", f.ID)
+		g.genFunc(f)
+		g.b.WriteString("\n")
+	}
+	g.genReturns(p.Funcs)
+
+	g.b.WriteString(g.bTempVar.String())
+	for _, data := range p.Data {
+		init := make([]string, len(data.Init))
+		for i, value := range data.Init {
+			init[i] = fmt.Sprintf("0x%X", value)
+		}
+		write(&g.b, "%v DCB %v\n", data.Name, strings.Join(init, ","))
+	}
+	for _, f := range p.Funcs {
+		write(&g.b, "ret_%v DCB 0x0\n", f.ID)
+	}
 
-	return b
+	return g.b
 }
 
-func newTempVariable(bTempVar *bytes.Buffer, value string) string {
-	tmpCount++
-	varName := fmt.Sprintf("temp_%v", tmpCount)
-	write(bTempVar, "%v DCB %v\n", varName, value)
+func (g *Generator) newTempVariable(value string) string {
+	g.tmpCount++
+	varName := fmt.Sprintf("temp_%v", g.tmpCount)
+	write(&g.bTempVar, "%v DCB %v\n", varName, value)
 
 	return varName
 }
 
-func newLabelNumber() int {
-	labelCount++
-	return labelCount
-}
-
-func gen(node ast.Node, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	switch node := node.(type) {
-	case *ast.Program:
-		return genProgram(node, b, bVar, bTempVar, bTabs)
-	case *ast.AssignStatement:
-		return genAssignStatement(node, b, bVar, bTempVar, bTabs)
-	case *ast.AssignTabStatement:
-		return genAssignTabStatement(node, b, bVar, bTempVar, bTabs)
-	case *ast.InitStatement:
-		return genInitStatement(node, b, bVar, bTempVar, bTabs)
-	case *ast.BlockStatement:
-		return genBlockStatement(node, b, bVar, bTempVar, bTabs)
-	case *ast.InfixExpression:
-		return genInfixExpression(node, b, bVar, bTempVar, bTabs)
-	case *ast.IntegerLiteral:
-		return genInteger(node, b, bVar, bTempVar, bTabs)
-	case *ast.Identifier:
-		return genIdentifier(node, b, bVar, bTempVar, bTabs)
-	case *ast.IfStatement:
-		return genIfStatement(node, b, bVar, bTempVar, bTabs)
-	case *ast.WhileStatement:
-		return genWhileStatement(node, b, bVar, bTempVar, bTabs)
-	case *ast.TabInitStatement:
-		return genTabInitStatement(node, b, bVar, bTempVar, bTabs)
-	case *ast.WaitStatement:
-		return genWaitStatement(node, b, bVar, bTempVar, bTabs)
-	case *ast.TabExpression:
-		return genTabExpression(node, b, bVar, bTempVar, bTabs)
-	}
-	return ""
+func (g *Generator) newLabelNumber() int {
+	g.labelCount++
+	return g.labelCount
 }
 
-func genProgram(node *ast.Program, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	for _, stmt := range node.Statements {
-		gen(stmt, b, bVar, bTempVar, bTabs)
+// allocate returns a slot for t, one of a temporary of the same function
+// that holds no value while t does when there is one. The slots are not
+// shared between functions, a temporary can be live across a call.
+func (g *Generator) allocate(t ir.Temp) string {
+	live := g.live[t]
+	for _, s := range g.slots {
+		free := true
+		for _, other := range s.intervals {
+			free = free && !live.Overlaps(other)
+		}
+		if free {
+			s.intervals = append(s.intervals, live)
+			return s.name
+		}
 	}
-	return ""
+
+	s := &slot{name: g.newTempVariable("0x0"), intervals: []ir.Interval{live}}
+	g.slots = append(g.slots, s)
+	return s.name
 }
 
-func genAssignStatement(node *ast.AssignStatement, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	right := gen(node.Right, b, bVar, bTempVar, bTabs)
-	write(b, "MOV R1, #%v\n", right)
-	write(b, "LDRB R0, [R1]\n")
-	switch node.Left.Value {
-	case "output":
-		write(b, "MOV R1, #0x8001\n")
-	default:
-		write(b, "MOV R1, #var_%v\n", node.Left.Value)
-	}
-	write(b, "STRB R0, [R1]\n\n")
-	return ""
-}
-
-func genAssignTabStatement(node *ast.AssignTabStatement, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	index := gen(node.Index, b, bVar, bTempVar, bTabs)
-	right := gen(node.Right, b, bVar, bTempVar, bTabs)
-	ident := node.Left.Value
-
-	write(b, "MOV R1, #%v\n", right)
-	write(b, "LDRB R3, [R1]\n")
-	write(b, "MOV R1, #%v\n", index)
-	write(b, "LDRB R0, [R1]\n")
-	switch ident {
-	case "screen":
-		write(b, "MOV R1, #0x4000\n")
-	default:
-		write(b, "MOV R1, #tab_%v\n", node.Left.Value)
+// address returns the operand of MOV R1 addressing the byte of v, which is
+// not a constant.
+func (g *Generator) address(v ir.Value) string {
+	switch v := v.(type) {
+	case ir.Temp:
+		if _, ok := g.temps[v]; !ok {
+			g.temps[v] = g.allocate(v)
+		}
+		return g.temps[v]
+	case ir.Var:
+		return v.Name
+	case ir.Port:
+		return fmt.Sprintf("0x%X", v.Addr)
 	}
-
-	write(b, "ADD R1, R1, R0\n")
-	write(b, "MOV R0, R3\n")
-	write(b, "STRB R0, [R1]\n")
-	return ""
+	panic(fmt.Sprintf("gen: unexpected value %T", v))
 }
 
-func genWaitStatement(node *ast.WaitStatement, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	write(b, "WAIT #0x%X\n", node.Time)
-	return ""
+// load puts the value of v into reg, R0 or R3, as an immediate operand
+// when it is a constant.
+func (g *Generator) load(reg string, v ir.Value) {
+	if c, ok := v.(ir.Const); ok {
+		write(&g.b, "MOV %v, #0x%X\n", reg, c.Value)
+		return
+	}
+	write(&g.b, "MOV R1, #%v\n", g.address(v))
+	write(&g.b, "LDRB %v, [R1]\n", reg)
 }
 
-func genInitStatement(node *ast.InitStatement, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	right := gen(node.Expr, b, bVar, bTempVar, bTabs)
-	write(b, "MOV R1, #%v\n", right)
-	write(b, "LDRB R0, [R1]\n")
-	write(b, "MOV R1, #var_%v\n", node.Location)
-	write(b, "STRB R0, [R1]\n\n")
+func (g *Generator) store(v ir.Value) {
+	write(&g.b, "MOV R1, #%v\n", g.address(v))
+	write(&g.b, "STRB R0, [R1]\n")
+}
 
-	write(bVar, "var_%v DCB 0x0\n", node.Location)
-	return ""
+// holds reports whether reg is known to hold v. The ports are never held,
+// reading them again gives a new value.
+func (g *Generator) holds(reg string, v ir.Value) bool {
+	return g.regs[reg] != nil && g.regs[reg] == v
 }
 
-func genTabInitStatement(node *ast.TabInitStatement, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	size := node.Size
-	defaultValue := fmt.Sprintf("0x%X", node.DefaultValue)
+// forget drops what the registers are known to hold, at a label or after a
+// call.
+func (g *Generator) forget() {
+	g.regs = map[string]ir.Value{}
+}
 
-	tabInit := strings.Repeat(defaultValue+",", size)
-	tabInit = strings.TrimRight(tabInit, ", ")
+// clobber is called before reg is overwritten. A local temporary that only
+// R0 holds is spilled to its slot first when it is still to be read.
+func (g *Generator) clobber(reg string) {
+	if t, ok := g.regs[reg].(ir.Temp); ok && reg == "R0" && g.local[t] && !g.spilled[t] && g.unread[t] > 0 {
+		g.store(t)
+		g.spilled[t] = true
+	}
+	g.regs[reg] = nil
+}
 
-	write(bTabs, "tab_%v DCB %v\n", node.Location, tabInit)
+// use puts v into reg for an instruction reading it, taking it from the
+// other register or keeping it when it is already there.
+func (g *Generator) use(reg string, v ir.Value) {
+	if t, ok := v.(ir.Temp); ok {
+		g.unread[t]--
+	}
+	if g.holds(reg, v) {
+		return
+	}
 
-	return ""
+	other := "R3"
+	if reg == "R3" {
+		other = "R0"
+	}
+	g.clobber(reg)
+	if g.holds(other, v) {
+		write(&g.b, "MOV %v, %v\n", reg, other)
+	} else {
+		g.load(reg, v)
+	}
+	if _, ok := v.(ir.Port); !ok {
+		g.regs[reg] = v
+	}
 }
 
-func genInteger(node *ast.IntegerLiteral, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	i, err := strconv.ParseUint(node.Value, 10, 32)
-	check(err)
-	hex := fmt.Sprintf("0x%X", i)
-	tmp := newTempVariable(bTempVar, hex)
-
-	return tmp
+// operands puts a into R0 and b into R3.
+func (g *Generator) operands(a, b ir.Value) {
+	if g.holds("R0", b) && !g.holds("R0", a) {
+		g.use("R3", b)
+		g.use("R0", a)
+		return
+	}
+	g.use("R0", a)
+	g.use("R3", b)
 }
 
-func genIdentifier(node *ast.Identifier, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	switch node.Value {
-	case "input":
-		return "0x8000"
-	case "random":
-		return "0xC000"
+// result writes the value computed in R0 to dst. A local temporary is left
+// in R0 only.
+func (g *Generator) result(dst ir.Value) {
+	held := g.regs["R0"]
+	g.clobber("R0")
+	switch dst := dst.(type) {
+	case ir.Temp:
+		if !g.local[dst] {
+			g.store(dst)
+		}
+		g.regs["R0"] = dst
+	case ir.Var:
+		if g.holds("R3", dst) {
+			g.regs["R3"] = nil
+		}
+		g.store(dst)
+		g.regs["R0"] = dst
 	default:
-		return "var_" + node.Value
+		g.store(dst)
+		g.regs["R0"] = held
 	}
 }
 
-func genInfixExpression(node *ast.InfixExpression, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	tempLabel := newLabelNumber()
-	left := gen(node.Left, b, bVar, bTempVar, bTabs)
-	right := gen(node.Right, b, bVar, bTempVar, bTabs)
-
-	write(b, "MOV R1, #%v\n", left)
-	write(b, "LDRB R0, [R1]\n")
-	write(b, "MOV R1, #%v\n", right)
-	write(b, "LDRB R3, [R1]\n")
-
-	switch node.Operator {
-	case "+", "-", "*", "&":
-		write(b, "%v R0, R0, R3\n", operatorToInstru[node.Operator])
-
-	case "==", "<":
-		write(b, "CMP R0, R3\n")
-		write(b, "MOV R1, #const_1\n")
-		write(b, "LDRB R0, [R1]\n")
-		write(b, "%v condtrue%v\n", operatorToInstru[node.Operator], tempLabel)
-		write(b, "MOV R1, #const_0\n")
-		write(b, "LDRB R0, [R1]\n")
-		write(b, "condtrue%v\n", tempLabel)
+// analyze finds the local temporaries of f, counts their reads and computes
+// their live intervals.
+func (g *Generator) analyze(f *ir.Func) {
+	g.slots = nil
+	g.live = ir.LiveIntervals(f)
+	g.local = map[ir.Temp]bool{}
+	g.unread = map[ir.Temp]int{}
+	g.spilled = map[ir.Temp]bool{}
+
+	defs := map[ir.Temp]int{}
+	blocks := map[ir.Temp]*ir.Block{}
+	read := func(block *ir.Block, values []ir.Value) {
+		for _, v := range values {
+			if t, ok := v.(ir.Temp); ok {
+				g.unread[t]++
+				if blocks[t] != block {
+					blocks[t] = nil
+				}
+			}
+		}
+	}
+	for _, block := range f.Blocks {
+		for _, instr := range block.Instrs {
+			read(block, ir.Operands(instr))
+			if t, ok := ir.Destination(instr).(ir.Temp); ok {
+				defs[t]++
+				blocks[t] = block
+			}
+		}
+		read(block, ir.TermOperands(block.Term))
 	}
 
-	tmp := newTempVariable(bTempVar, "0x0")
+	for t, n := range defs {
+		g.local[t] = n == 1 && blocks[t] != nil
+	}
+}
+
+// genFunc generates the blocks of f in their layout order, so that a block
+// ending with a jump to the next one falls through to it.
+func (g *Generator) genFunc(f *ir.Func) {
+	g.analyze(f)
+	g.forget()
+	g.targets = map[*ir.Block]bool{}
+	for _, block := range f.Blocks {
+		switch term := block.Term.(type) {
+		case ir.Jump:
+			g.targets[term.Target] = true
+		case ir.Branch:
+			g.targets[term.Then] = true
+			g.targets[term.Else] = true
+		}
+	}
 
-	write(b, "MOV R1, #%v\n", tmp)
-	write(b, "STRB R0, [R1]\n\n")
+	for i, block := range f.Blocks {
+		if g.targets[block] {
+			write(&g.b, "%v\n", block.Label)
+			g.forget()
+		}
+		for _, instr := range block.Instrs {
+			g.genInstr(instr)
+		}
+
+		var next *ir.Block
+		if i+1 < len(f.Blocks) {
+			next = f.Blocks[i+1]
+		}
+		g.genTerm(f, block.Term, next)
+	}
+}
 
-	return tmp
+func (g *Generator) genInstr(instr ir.Instr) {
+	switch instr := instr.(type) {
+	case ir.Copy:
+		g.use("R0", instr.Src)
+		g.result(instr.Dst)
+	case ir.BinOp:
+		if _, ok := comparisons[instr.Op]; ok {
+			label := fmt.Sprintf("cmptrue%v", g.newLabelNumber())
+			cmp := g.genCompare(instr.Op, instr.Left, instr.Right)
+			g.clobber("R0")
+			g.load("R0", ir.Const{Value: 1})
+			writeBranches(&g.b, cmp, label)
+			g.load("R0", ir.Const{Value: 0})
+			write(&g.b, "%v\n", label)
+		} else {
+			g.genArithmetic(instr)
+		}
+		g.result(instr.Dst)
+	case ir.Load:
+		g.use("R0", instr.Index)
+		g.clobber("R0")
+		write(&g.b, "MOV R1, #%v\n", g.address(instr.Base))
+		write(&g.b, "ADD R1, R1, R0\n")
+		write(&g.b, "LDRB R0, [R1]\n")
+		g.result(instr.Dst)
+	case ir.Store:
+		g.operands(instr.Index, instr.Src)
+		g.clobber("R0")
+		write(&g.b, "MOV R1, #%v\n", g.address(instr.Base))
+		write(&g.b, "ADD R1, R1, R0\n")
+		write(&g.b, "MOV R0, R3\n")
+		write(&g.b, "STRB R0, [R1]\n")
+		g.regs["R0"] = g.regs["R3"]
+	case ir.Wait:
+		write(&g.b, "WAIT #0x%X\n", instr.Time)
+	case ir.Call:
+		g.genCall(instr)
+	}
 }
 
-func genIfStatement(node *ast.IfStatement, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	cond := gen(node.Condition, b, bVar, bTempVar, bTabs)
+// genArithmetic leaves in R0 the result of an arithmetic instruction. The
+// CPU adds an immediate operand, which also subtracts a constant since the
+// arithmetic wraps around on 8 bits.
+func (g *Generator) genArithmetic(op ir.BinOp) {
+	left, right := op.Left, op.Right
+	_, constLeft := left.(ir.Const)
+	_, constRight := right.(ir.Const)
+	if commutative[op.Op] && (constLeft || !constRight && g.swapOperands(op.Op, left, right)) {
+		left, right = right, left
+	}
 
-	labelId := newLabelNumber()
+	if c, ok := right.(ir.Const); ok && (op.Op == "+" || op.Op == "-") {
+		value := c.Value
+		if op.Op == "-" {
+			value = -value & 0xFF
+		}
+		g.use("R0", left)
+		g.clobber("R0")
+		write(&g.b, "ADD R0, R0, #0x%X\n", value)
+		return
+	}
 
-	write(b, "MOV R1, #%v\n", cond)
-	write(b, "LDRB R0, [R1]\n")
-	write(b, "MOV R1, #const_1\n")
-	write(b, "LDRB R3, [R1]\n")
-	write(b, "CMP R0, R3\n")
-	write(b, "BNE else%v\n", labelId)
-	gen(node.Block, b, bVar, bTempVar, bTabs)
-	write(b, "B ifend%v\n", labelId)
-	write(b, "else%v\n", labelId)
-	gen(node.Alternative, b, bVar, bTempVar, bTabs)
-	write(b, "ifend%v\n", labelId)
-	return ""
+	g.operands(left, right)
+	g.clobber("R0")
+	write(&g.b, "%v R0, R0, R3\n", operatorToInstru[op.Op])
 }
 
-func genWhileStatement(node *ast.WhileStatement, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	labelId := newLabelNumber()
-	write(b, "startwhile%v\n", labelId)
-	cond := gen(node.Condition, b, bVar, bTempVar, bTabs)
-	write(b, "MOV R1, #%v\n", cond)
-	write(b, "LDRB R0, [R1]\n")
-	write(b, "MOV R1, #const_1\n")
-	write(b, "LDRB R3, [R1]\n")
-	write(b, "CMP R0, R3\n")
-	write(b, "BNE endwhile%v\n", labelId)
-	gen(node.Block, b, bVar, bTempVar, bTabs)
-	write(b, "B startwhile%v\n", labelId)
-	write(b, "endwhile%v\n", labelId)
-
-	return ""
+// swapOperands reports whether the operands of a commutative operator are
+// better swapped, because the registers hold them the other way around.
+func (g *Generator) swapOperands(op string, left, right ir.Value) bool {
+	return commutative[op] && !g.holds("R0", left) && (g.holds("R0", right) || g.holds("R3", left))
 }
 
-func genBlockStatement(node *ast.BlockStatement, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	for _, stmt := range node.Statements {
-		gen(stmt, b, bVar, bTempVar, bTabs)
+// genCompare compares the operands of the comparison op, leaving the result
+// in the flags, and returns the branches taken when it holds.
+func (g *Generator) genCompare(op string, left, right ir.Value) comparison {
+	cmp := comparisons[op]
+	if cmp.swap || g.swapOperands(op, left, right) {
+		left, right = right, left
 	}
-	return ""
+	g.operands(left, right)
+	write(&g.b, "CMP R0, R3\n")
+	return cmp
 }
 
-func genTabExpression(node *ast.TabExpression, b, bVar, bTempVar, bTabs *bytes.Buffer) string {
-	tmp := newTempVariable(bTempVar, "0x0")
+func writeBranches(b *bytes.Buffer, cmp comparison, label string) {
+	for _, branch := range cmp.branches {
+		write(b, "%v %v\n", branch, label)
+	}
+}
 
-	idx := gen(node.Index, b, bVar, bTempVar, bTabs)
-	tabName := node.Ident.Value
+// genTerm generates the terminator of a block followed by next in the
+// layout, nil for the last block of f.
+func (g *Generator) genTerm(f *ir.Func, term ir.Term, next *ir.Block) {
+	switch term := term.(type) {
+	case ir.Jump:
+		if term.Target != next {
+			write(&g.b, "B %v\n", term.Target.Label)
+		}
+	case ir.Branch:
+		// branch on the negated comparison when the block taken on success
+		// comes next, so that it is reached by falling through
+		switch {
+		case term.Then == next:
+			cmp := g.genCompare(negatedComparisons[term.Op], term.Left, term.Right)
+			writeBranches(&g.b, cmp, term.Else.Label)
+		case term.Else == next:
+			cmp := g.genCompare(term.Op, term.Left, term.Right)
+			writeBranches(&g.b, cmp, term.Then.Label)
+		default:
+			cmp := g.genCompare(term.Op, term.Left, term.Right)
+			writeBranches(&g.b, cmp, term.Then.Label)
+			write(&g.b, "B %v\n", term.Else.Label)
+		}
+	case ir.Return:
+		if term.Value != nil {
+			g.use("R0", term.Value)
+			g.result(*f.Result)
+		}
+		write(&g.b, "B procret%v\n", f.ID)
+	case ir.Halt:
+		if next != nil {
+			write(&g.b, "B endprog\n")
+		}
+	}
+}
 
+// genCall copies the arguments into the parameter slots of the callee and
+// branches to it. The CPU has no call instruction, so the number of the
+// call site is stored in ret_N for the return dispatch of the callee. The
+// callee overwrites the registers.
+func (g *Generator) genCall(call ir.Call) {
+	f := call.Func
+	for i, arg := range call.Args {
+		g.use("R0", arg)
+		g.result(f.Params[i])
+	}
 
-	write(b, "MOV R1, #%v\n", idx)
-	write(b, "LDRB R0, [R1]\n")
-	write(b, "MOV R1, #tab_%v\n", tabName)
-	write(b, "ADD R1, R1, R0\n")
-	write(b, "LDRB R0, [R1]\n")
-	write(b, "MOV R1, #%v\n", tmp)
-	write(b, "STRB R0, [R1]\n")
+	site := g.newLabelNumber()
+	g.callSites[f] = append(g.callSites[f], site)
+
+	g.clobber("R0")
+	g.load("R0", ir.Const{Value: len(g.callSites[f])})
+	write(&g.b, "MOV R1, #ret_%v\n", f.ID)
+	write(&g.b, "STRB R0, [R1]\n")
+	write(&g.b, "B proc%v\n", f.ID)
+	write(&g.b, "callret%v\n\n", site)
+	g.forget()
+
+	if call.Dst != nil {
+		// copy the result out so that a second call to the same fn in the
+		// expression does not overwrite it
+		g.use("R0", *f.Result)
+		g.result(call.Dst)
+		g.b.WriteString("\n")
+	}
+}
 
-	return tmp
+// genReturns emits the return dispatch of every function, which compares
+// the number stored in ret_N against every call site to branch back to
+// the caller.
+func (g *Generator) genReturns(funcs []*ir.Func) {
+	for _, f := range funcs {
+		write(&g.b, "procret%v\n", f.ID)
+
+		sites := g.callSites[f]
+		if len(sites) == 0 {
+			write(&g.b, "B endprog\n\n")
+			continue
+		}
+
+		write(&g.b, "MOV R1, #ret_%v\n", f.ID)
+		write(&g.b, "LDRB R0, [R1]\n")
+		last := len(sites) - 1
+		for i, site := range sites[:last] {
+			g.load("R3", ir.Const{Value: i + 1})
+			write(&g.b, "CMP R0, R3\n")
+			write(&g.b, "BEQ callret%v\n", site)
+		}
+		write(&g.b, "B callret%v\n\n", sites[last])
+	}
 }
@@ -0,0 +1,215 @@
+package gen_test
+
+import (
+	"fmt"
+	"minicompiler/compiler"
+	"minicompiler/diag"
+	"minicompiler/ir"
+	"minicompiler/sim"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// build compiles src.
+func build(t *testing.T, src string) *compiler.Result {
+	t.Helper()
+	result, diags := compiler.Compile([]byte(src), compiler.Options{})
+	if diag.HasErrors(diags) {
+		t.Fatalf("compiling %q: %v", src, diags)
+	}
+	return result
+}
+
+// run compiles src and runs it in the simulator with the given input, and
+// returns the bytes it writes to the output.
+func run(t *testing.T, src string, input ...uint8) []uint8 {
+	t.Helper()
+	return execute(t, build(t, src), input...)
+}
+
+// execute runs a compiled program in the simulator with the given input,
+// and returns the bytes it writes to the output.
+func execute(t *testing.T, result *compiler.Result, input ...uint8) []uint8 {
+	t.Helper()
+	m, err := sim.LoadMif(result.Mif)
+	if err != nil {
+		t.Fatalf("loading the MIF: %v", err)
+	}
+	m.Input = func() uint8 {
+		if len(input) == 0 {
+			t.Fatalf("the program reads more input than given")
+		}
+		value := input[0]
+		input = input[1:]
+		return value
+	}
+	if err := m.Run(100000); err != nil {
+		t.Fatalf("running the program: %v", err)
+	}
+	return m.Output
+}
+
+func TestComparisons(t *testing.T) {
+	operators := []struct {
+		op   string
+		eval func(a, b uint8) bool
+	}{
+		{"==", func(a, b uint8) bool { return a == b }},
+		{"!=", func(a, b uint8) bool { return a != b }},
+		{"<", func(a, b uint8) bool { return a < b }},
+		{">", func(a, b uint8) bool { return a > b }},
+		{"<=", func(a, b uint8) bool { return a <= b }},
+		{">=", func(a, b uint8) bool { return a >= b }},
+	}
+	// the operands are read from the input so that they are not folded,
+	// or written as an immediate operand for the %[3]v forms
+	forms := []struct {
+		name string
+		src  string
+	}{
+		{"stored", "@ a = input; @ b = input; @ x = 0; x = a %[1]v b; output = x;"},
+		{"branch", "@ a = input; @ b = input; if a %[1]v b { output = 1; } else { output = 0; }"},
+		{"stored immediate", "@ a = input; @ b = input; @ x = 0; x = a %[1]v %[3]v; output = x;"},
+		{"branch immediate", "@ a = input; @ b = input; if %[2]v %[1]v b { output = 1; } else { output = 0; }"},
+	}
+	operands := [][2]uint8{
+		{0, 0}, {255, 255}, {7, 7},
+		{0, 1}, {1, 0},
+		{0, 255}, {255, 0},
+		{254, 255}, {255, 254},
+		{3, 200}, {200, 3},
+	}
+
+	for _, form := range forms {
+		for _, operator := range operators {
+			for _, operand := range operands {
+				a, b := operand[0], operand[1]
+				src := fmt.Sprintf(form.src, operator.op, a, b)
+				t.Run(fmt.Sprintf("%v %v %v %v", form.name, a, operator.op, b), func(t *testing.T) {
+					want := uint8(0)
+					if operator.eval(a, b) {
+						want = 1
+					}
+					output := run(t, src, a, b)
+					if len(output) != 1 || output[0] != want {
+						t.Errorf("%q with a = %v, b = %v: output %v, want [%v]", src, a, b, output, want)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestMatchReadsPortOnce(t *testing.T) {
+	src := "match input { 1 => { output = 1; } 2 => { output = 2; } _ => { output = 9; } }"
+	tests := []struct {
+		input []uint8
+		want  uint8
+	}{
+		{[]uint8{1, 2}, 1},
+		{[]uint8{2, 7}, 2},
+		{[]uint8{7, 1}, 9},
+	}
+	for _, test := range tests {
+		output := run(t, src, test.input...)
+		if len(output) != 1 || output[0] != test.want {
+			t.Errorf("input %v: output %v, want [%v]", test.input, output, test.want)
+		}
+	}
+}
+
+func TestOperandsReadBeforeLaterCall(t *testing.T) {
+	tests := []struct {
+		src   string
+		input []uint8
+		want  uint8
+	}{
+		{"@ v = 5; fn h(x) { v = x; return 10; } output = v + h(1);", nil, 15},
+		{"fn f() { return input; } output = input - f();", []uint8{10, 3}, 7},
+	}
+	for _, test := range tests {
+		output := run(t, test.src, test.input...)
+		if len(output) != 1 || output[0] != test.want {
+			t.Errorf("%q: output %v, want [%v]", test.src, output, test.want)
+		}
+	}
+}
+
+// storedTemps returns the number of temporaries of p that cannot stay in a
+// register: those read in another block than the one writing them, or
+// after a call.
+func storedTemps(p *ir.Program) int {
+	count := 0
+	for _, f := range append([]*ir.Func{p.Main}, p.Funcs...) {
+		written := map[ir.Temp]*ir.Block{}
+		stored := map[ir.Temp]bool{}
+		for _, block := range f.Blocks {
+			for _, instr := range block.Instrs {
+				if t, ok := ir.Destination(instr).(ir.Temp); ok {
+					written[t] = block
+				}
+			}
+		}
+		for _, block := range f.Blocks {
+			called := map[ir.Temp]bool{}
+			read := func(values []ir.Value) {
+				for _, v := range values {
+					if t, ok := v.(ir.Temp); ok && (written[t] != block || called[t]) {
+						stored[t] = true
+					}
+				}
+			}
+			for _, instr := range block.Instrs {
+				read(ir.Operands(instr))
+				if _, ok := instr.(ir.Call); ok {
+					for t := range written {
+						called[t] = true
+					}
+				}
+				if t, ok := ir.Destination(instr).(ir.Temp); ok {
+					called[t] = false
+				}
+			}
+			read(ir.TermOperands(block.Term))
+		}
+		count += len(stored)
+	}
+	return count
+}
+
+// TestTempSlotsShared runs a program whose temporaries are live around the
+// body of while loops and across a call of a fn with temporaries of its
+// own, and checks that they share data slots without clobbering each other.
+func TestTempSlotsShared(t *testing.T) {
+	src := `
+@ i = 0;
+@ j = 0;
+@ y = 0;
+while (i < 4 && j < 9) + (i == 0 || j > 1) == 2 {
+    y = y + (i < 2 && j == 0) + (i > 0 || j > 4);
+    i = i + 1;
+    j = j + 2;
+}
+output = y;
+fn f(n) {
+    @ k = 0;
+    @ s = 0;
+    while k < n {
+        s = s + (k < 2 && k > 0) + (k == 1 || k == 2);
+        k = k + 1;
+    }
+    return s;
+}
+output = (i == 4 && j == 8) + f(3) + (j > 5 || i > 9);
+`
+	result := build(t, src)
+	if output, want := execute(t, result), []uint8{4, 5}; !reflect.DeepEqual(output, want) {
+		t.Errorf("output %v, want %v", output, want)
+	}
+
+	slots := strings.Count(result.Asm, "\ntemp_")
+	if stored := storedTemps(result.IR); slots == 0 || slots >= stored {
+		t.Errorf("%v temp_ slots for %v stored temporaries, want fewer", slots, stored)
+	}
+}
@@ -0,0 +1,362 @@
+package ir
+
+// definition is the single instruction writing a temporary or a variable
+// with a constant.
+type definition struct {
+	fn    *Func
+	block *Block
+	index int
+	value int
+}
+
+// Fold evaluates at compile time the arithmetic and the comparisons whose
+// operands are known, with the 8-bit wraparound of the CPU. The value of a
+// temporary or a variable written once with a constant is propagated to the
+// reads that are sure to follow the write, which often makes more operands
+// known. Branches decided at compile time become jumps, and the blocks and
+// the temporaries left unused are removed.
+func Fold(p *Program) {
+	for changed := true; changed; {
+		defs := constantDefinitions(p)
+		changed = false
+		for _, f := range p.funcs() {
+			if foldFunc(p, f, defs) {
+				changed = true
+			}
+		}
+	}
+
+	for _, f := range p.funcs() {
+		removeUnusedTemps(f)
+	}
+}
+
+func (p *Program) funcs() []*Func {
+	return append([]*Func{p.Main}, p.Funcs...)
+}
+
+// constantDefinitions returns the temporaries and variables written by a
+// single instruction copying a constant into them. The parameters and the
+// results are written by every call and never qualify.
+func constantDefinitions(p *Program) map[Value]*definition {
+	writes := map[Value]int{}
+	defs := map[Value]*definition{}
+	for _, f := range p.Funcs {
+		for _, param := range f.Params {
+			writes[param] += 2
+		}
+		if f.Result != nil {
+			writes[*f.Result] += 2
+		}
+	}
+
+	for _, f := range p.funcs() {
+		for _, block := range f.Blocks {
+			for i, instr := range block.Instrs {
+				dst := Destination(instr)
+				if dst == nil {
+					continue
+				}
+				writes[dst]++
+				if copy, ok := instr.(Copy); ok {
+					if c, ok := copy.Src.(Const); ok {
+						defs[dst] = &definition{fn: f, block: block, index: i, value: c.Value}
+					}
+				}
+			}
+		}
+	}
+
+	for v := range defs {
+		if writes[v] != 1 {
+			delete(defs, v)
+		}
+	}
+	return defs
+}
+
+// foldFunc propagates the constants of defs into f and folds its
+// instructions, and reports whether it changed anything.
+func foldFunc(p *Program, f *Func, defs map[Value]*definition) bool {
+	changed := false
+	dominated := map[*Block]map[*Block]bool{}
+
+	for _, block := range f.Blocks {
+		known := func(index int) func(Value) Value {
+			return func(v Value) Value {
+				def := defs[v]
+				if def == nil {
+					return v
+				}
+				if _, ok := v.(Temp); !ok && !def.precedes(f, block, index, dominated) && def.value != p.initial(v) {
+					return v
+				}
+				changed = true
+				return Const{def.value}
+			}
+		}
+
+		for i, instr := range block.Instrs {
+			instr = mapOperands(instr, known(i))
+			if op, ok := instr.(BinOp); ok {
+				if value, ok := evaluate(op.Op, op.Left, op.Right); ok {
+					instr = Copy{op.Dst, Const{value}}
+					changed = true
+				}
+			}
+			block.Instrs[i] = instr
+		}
+
+		switch term := block.Term.(type) {
+		case Branch:
+			use := known(len(block.Instrs))
+			term.Left, term.Right = use(term.Left), use(term.Right)
+			block.Term = term
+			if value, ok := evaluate(term.Op, term.Left, term.Right); ok {
+				if value == 1 {
+					block.Term = Jump{term.Then}
+				} else {
+					block.Term = Jump{term.Else}
+				}
+				changed = true
+			}
+		case Return:
+			if term.Value != nil {
+				block.Term = Return{known(len(block.Instrs))(term.Value)}
+			}
+		}
+	}
+
+	if threadJumps(f) {
+		changed = true
+	}
+	if removeUnreachable(f) {
+		changed = true
+	}
+	return changed
+}
+
+// threadJumps makes the terminators of f continue directly at the end of
+// the chains of empty blocks that only jump further, such as the start of a
+// while 1 loop, and reports whether it changed any.
+func threadJumps(f *Func) bool {
+	changed := false
+	target := func(b *Block) *Block {
+		seen := map[*Block]bool{}
+		for len(b.Instrs) == 0 && !seen[b] {
+			jump, ok := b.Term.(Jump)
+			if !ok {
+				break
+			}
+			seen[b] = true
+			b = jump.Target
+		}
+		return b
+	}
+
+	for _, block := range f.Blocks {
+		switch term := block.Term.(type) {
+		case Jump:
+			if t := target(term.Target); t != term.Target {
+				block.Term = Jump{t}
+				changed = true
+			}
+		case Branch:
+			then, alt := target(term.Then), target(term.Else)
+			if then != term.Then || alt != term.Else {
+				term.Then, term.Else = then, alt
+				block.Term = term
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// precedes reports whether the definition is executed before the
+// instruction at index in block of f every time control reaches it, that
+// is whether the block of the definition dominates block.
+func (def *definition) precedes(f *Func, block *Block, index int, dominated map[*Block]map[*Block]bool) bool {
+	if def.fn != f {
+		return false
+	}
+	if def.block == block {
+		return def.index < index
+	}
+	if dominated[def.block] == nil {
+		dominated[def.block] = dominatedBy(f, def.block)
+	}
+	return dominated[def.block][block]
+}
+
+// dominatedBy returns the blocks of f that cannot be reached from the entry
+// without going through b.
+func dominatedBy(f *Func, b *Block) map[*Block]bool {
+	reached := map[*Block]bool{b: true}
+	if f.Blocks[0] != b {
+		visit(f.Blocks[0], reached)
+	}
+
+	dominated := map[*Block]bool{}
+	for _, block := range f.Blocks {
+		if !reached[block] {
+			dominated[block] = true
+		}
+	}
+	return dominated
+}
+
+// visit adds to reached the blocks reachable from b that are not already
+// in it.
+func visit(b *Block, reached map[*Block]bool) {
+	if reached[b] {
+		return
+	}
+	reached[b] = true
+	for _, succ := range b.Successors() {
+		visit(succ, reached)
+	}
+}
+
+// initial returns the value v holds when the program starts, 0 for the
+// temporaries and the variables without data.
+func (p *Program) initial(v Value) int {
+	if v, ok := v.(Var); ok {
+		for _, data := range p.Data {
+			if data.Name == v.Name && len(data.Init) > 0 {
+				return data.Init[0]
+			}
+		}
+	}
+	return 0
+}
+
+// removeUnreachable removes the blocks of f that control never reaches and
+// reports whether there were some.
+func removeUnreachable(f *Func) bool {
+	reached := map[*Block]bool{}
+	visit(f.Blocks[0], reached)
+
+	blocks := f.Blocks[:0]
+	for _, block := range f.Blocks {
+		if reached[block] {
+			blocks = append(blocks, block)
+		}
+	}
+	removed := len(blocks) != len(f.Blocks)
+	f.Blocks = blocks
+	return removed
+}
+
+// removeUnusedTemps removes the instructions computing a temporary that is
+// never read, unless they read a port.
+func removeUnusedTemps(f *Func) {
+	for removed := true; removed; {
+		used := map[Value]bool{}
+		for _, block := range f.Blocks {
+			for _, instr := range block.Instrs {
+				for _, v := range Operands(instr) {
+					used[v] = true
+				}
+			}
+			for _, v := range TermOperands(block.Term) {
+				used[v] = true
+			}
+		}
+
+		removed = false
+		for _, block := range f.Blocks {
+			instrs := block.Instrs[:0]
+			for _, instr := range block.Instrs {
+				if dst, ok := Destination(instr).(Temp); ok && !used[dst] && pure(instr) {
+					removed = true
+					continue
+				}
+				instrs = append(instrs, instr)
+			}
+			block.Instrs = instrs
+		}
+	}
+}
+
+// pure reports whether instr has no effect besides writing its destination.
+func pure(instr Instr) bool {
+	switch instr.(type) {
+	case Copy, BinOp, Load:
+		for _, v := range Operands(instr) {
+			if _, ok := v.(Port); ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// mapOperands returns instr with every value it reads replaced by fn.
+func mapOperands(instr Instr, fn func(Value) Value) Instr {
+	switch instr := instr.(type) {
+	case Copy:
+		instr.Src = fn(instr.Src)
+		return instr
+	case BinOp:
+		instr.Left, instr.Right = fn(instr.Left), fn(instr.Right)
+		return instr
+	case Load:
+		instr.Index = fn(instr.Index)
+		return instr
+	case Store:
+		instr.Index, instr.Src = fn(instr.Index), fn(instr.Src)
+		return instr
+	case Call:
+		args := make([]Value, len(instr.Args))
+		for i, arg := range instr.Args {
+			args[i] = fn(arg)
+		}
+		instr.Args = args
+		return instr
+	}
+	return instr
+}
+
+// evaluate computes left op right when both are constants, on 8 bits like
+// the CPU. A comparison gives 1 when it holds and 0 otherwise.
+func evaluate(op string, left, right Value) (int, bool) {
+	l, okLeft := left.(Const)
+	r, okRight := right.(Const)
+	if !okLeft || !okRight {
+		return 0, false
+	}
+
+	a, b := l.Value&0xFF, r.Value&0xFF
+	switch op {
+	case "+":
+		return (a + b) & 0xFF, true
+	case "-":
+		return (a - b) & 0xFF, true
+	case "*":
+		return (a * b) & 0xFF, true
+	case "&":
+		return a & b, true
+	case "==":
+		return boolValue(a == b), true
+	case "!=":
+		return boolValue(a != b), true
+	case "<":
+		return boolValue(a < b), true
+	case ">":
+		return boolValue(a > b), true
+	case "<=":
+		return boolValue(a <= b), true
+	case ">=":
+		return boolValue(a >= b), true
+	}
+	return 0, false
+}
+
+func boolValue(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,100 @@
+package ir_test
+
+import (
+	"minicompiler/compiler"
+	"minicompiler/diag"
+	"minicompiler/ir"
+	"minicompiler/sim"
+	"reflect"
+	"testing"
+)
+
+// compile compiles src and returns its folded IR and the bytes it writes
+// to the output when run in the simulator.
+func compile(t *testing.T, src string) (*ir.Program, []uint8) {
+	t.Helper()
+	result, diags := compiler.Compile([]byte(src), compiler.Options{})
+	if diag.HasErrors(diags) {
+		t.Fatalf("compiling %q: %v", src, diags)
+	}
+
+	m, err := sim.LoadMif(result.Mif)
+	if err != nil {
+		t.Fatalf("loading the MIF of %q: %v", src, err)
+	}
+	if err := m.Run(100000); err != nil {
+		t.Fatalf("running %q: %v", src, err)
+	}
+	return result.IR, m.Output
+}
+
+// outputs returns the constants main writes to the output, in order.
+func outputs(p *ir.Program) []int {
+	var values []int
+	for _, block := range p.Main.Blocks {
+		for _, instr := range block.Instrs {
+			if copy, ok := instr.(ir.Copy); ok && copy.Dst == ir.Output {
+				if c, ok := copy.Src.(ir.Const); ok {
+					values = append(values, c.Value)
+				}
+			}
+		}
+	}
+	return values
+}
+
+func TestFoldWrapsAround(t *testing.T) {
+	src := "output = 255 + 2; output = 1 - 2; output = 16 * 17; @ x = 200; output = x + 100;"
+	p, output := compile(t, src)
+
+	want := []int{1, 255, 16, 44}
+	if got := outputs(p); !reflect.DeepEqual(got, want) {
+		t.Errorf("folded outputs %v, want %v\n%v", got, want, p)
+	}
+	if !reflect.DeepEqual(output, []uint8{1, 255, 16, 44}) {
+		t.Errorf("output %v, want %v", output, want)
+	}
+}
+
+// TestFoldKeepsReadsBeforeWrite checks that the value of a variable written
+// once is not propagated to the reads that may run before the write.
+func TestFoldKeepsReadsBeforeWrite(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []uint8
+	}{
+		{
+			"loop header",
+			"fn get() { return x; } @ i = 0; while get() + i < 3 { output = get(); i = i + 1; } @ x = 9; output = get();",
+			[]uint8{0, 0, 0, 9},
+		},
+		{
+			"proc called before the write",
+			"proc p { output = x; } p(); @ x = 7; p();",
+			[]uint8{0, 7},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, output := compile(t, test.src)
+			if !reflect.DeepEqual(output, test.want) {
+				t.Errorf("output %v, want %v\n%v", output, test.want, p)
+			}
+		})
+	}
+}
+
+func TestFoldBranches(t *testing.T) {
+	src := "@ x = 3; if x == 3 { output = 1; } else { output = 2; } while x < 3 { output = 9; } if 1 && x > 2 { output = 4; }"
+	p, output := compile(t, src)
+
+	for _, block := range p.Main.Blocks {
+		if _, ok := block.Term.(ir.Branch); ok {
+			t.Errorf("branch left in block %v\n%v", block.Label, p)
+		}
+	}
+	if want := []uint8{1, 4}; !reflect.DeepEqual(output, want) {
+		t.Errorf("output %v, want %v", output, want)
+	}
+}
@@ -0,0 +1,327 @@
+// Package ir holds the intermediate representation between the syntax tree
+// and the assembly. A program is a set of functions made of basic blocks of
+// three-address instructions, each block ending with a terminator that
+// transfers control to other blocks. Lower builds it from the syntax tree
+// and the gen package selects the instructions of the CPU from it.
+package ir
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Value is an operand of an instruction.
+type Value interface {
+	fmt.Stringer
+	value()
+}
+
+// Temp is a temporary of the compiler, it is written once by the
+// instruction computing it.
+type Temp struct {
+	ID int
+}
+
+// Const is an 8-bit constant.
+type Const struct {
+	Value int
+}
+
+// Var is the data label of a variable, a table or the parameter and
+// result slots of a function.
+type Var struct {
+	Name string
+}
+
+// Port is an address of the memory map outside of the memory image, read
+// and written for their side effects.
+type Port struct {
+	Addr int
+}
+
+func (Temp) value()  {}
+func (Const) value() {}
+func (Var) value()   {}
+func (Port) value()  {}
+
+func (t Temp) String() string  { return fmt.Sprintf("t%v", t.ID) }
+func (c Const) String() string { return fmt.Sprintf("%v", c.Value) }
+func (v Var) String() string   { return v.Name }
+func (p Port) String() string  { return fmt.Sprintf("[0x%X]", p.Addr) }
+
+// The ports of the board.
+var (
+	Screen = Port{0x4000}
+	Input  = Port{0x8000}
+	Output = Port{0x8001}
+	Random = Port{0xC000}
+)
+
+// Instr is an instruction of a basic block.
+type Instr interface {
+	fmt.Stringer
+	instr()
+}
+
+// Copy stores Src into Dst.
+type Copy struct {
+	Dst, Src Value
+}
+
+// BinOp stores Left Op Right into Dst. Op is one of + - * & for arithmetic,
+// which wraps around on 8 bits, or a comparison == != < > <= >= giving 1
+// when it holds and 0 otherwise.
+type BinOp struct {
+	Op          string
+	Dst         Value
+	Left, Right Value
+}
+
+// Load stores into Dst the byte at Index in the table starting at Base.
+type Load struct {
+	Dst         Value
+	Base, Index Value
+}
+
+// Store writes Src at Index in the table starting at Base.
+type Store struct {
+	Base, Index Value
+	Src         Value
+}
+
+// Wait pauses the program, Time is in the unit of the WAIT instruction.
+type Wait struct {
+	Time int
+}
+
+// Call runs Func with Args copied into its parameters and stores its
+// result into Dst, which is nil for a proc.
+type Call struct {
+	Dst  Value
+	Func *Func
+	Args []Value
+}
+
+func (Copy) instr()  {}
+func (BinOp) instr() {}
+func (Load) instr()  {}
+func (Store) instr() {}
+func (Wait) instr()  {}
+func (Call) instr()  {}
+
+func (i Copy) String() string  { return fmt.Sprintf("%v = %v", i.Dst, i.Src) }
+func (i BinOp) String() string { return fmt.Sprintf("%v = %v %v %v", i.Dst, i.Left, i.Op, i.Right) }
+func (i Load) String() string  { return fmt.Sprintf("%v = %v[%v]", i.Dst, i.Base, i.Index) }
+func (i Store) String() string { return fmt.Sprintf("%v[%v] = %v", i.Base, i.Index, i.Src) }
+func (i Wait) String() string  { return fmt.Sprintf("wait %v", i.Time) }
+
+func (i Call) String() string {
+	call := fmt.Sprintf("call %v(%v)", i.Func.Name, values(i.Args))
+	if i.Dst == nil {
+		return call
+	}
+	return fmt.Sprintf("%v = %v", i.Dst, call)
+}
+
+// Destination returns the temporary or variable written by instr, nil when
+// it writes none.
+func Destination(instr Instr) Value {
+	var dst Value
+	switch instr := instr.(type) {
+	case Copy:
+		dst = instr.Dst
+	case BinOp:
+		dst = instr.Dst
+	case Load:
+		dst = instr.Dst
+	case Call:
+		dst = instr.Dst
+	}
+	switch dst.(type) {
+	case Temp, Var:
+		return dst
+	}
+	return nil
+}
+
+// Operands returns the values read by instr, in the order it reads them.
+func Operands(instr Instr) []Value {
+	switch instr := instr.(type) {
+	case Copy:
+		return []Value{instr.Src}
+	case BinOp:
+		return []Value{instr.Left, instr.Right}
+	case Load:
+		return []Value{instr.Index}
+	case Store:
+		return []Value{instr.Index, instr.Src}
+	case Call:
+		return instr.Args
+	}
+	return nil
+}
+
+// Term is the instruction ending a basic block.
+type Term interface {
+	fmt.Stringer
+	term()
+}
+
+// Jump continues at Target.
+type Jump struct {
+	Target *Block
+}
+
+// Branch continues at Then when the comparison Left Op Right holds and at
+// Else otherwise.
+type Branch struct {
+	Op          string
+	Left, Right Value
+	Then, Else  *Block
+}
+
+// Return leaves the function, storing Value into its result when the
+// function has one.
+type Return struct {
+	Value Value
+}
+
+// Halt ends the program.
+type Halt struct{}
+
+func (Jump) term()   {}
+func (Branch) term() {}
+func (Return) term() {}
+func (Halt) term()   {}
+
+func (t Jump) String() string { return fmt.Sprintf("jump %v", t.Target.Label) }
+
+func (t Branch) String() string {
+	return fmt.Sprintf("if %v %v %v then %v else %v", t.Left, t.Op, t.Right, t.Then.Label, t.Else.Label)
+}
+
+func (t Return) String() string {
+	if t.Value == nil {
+		return "return"
+	}
+	return fmt.Sprintf("return %v", t.Value)
+}
+
+func (Halt) String() string { return "halt" }
+
+// TermOperands returns the values read by term.
+func TermOperands(term Term) []Value {
+	switch term := term.(type) {
+	case Branch:
+		return []Value{term.Left, term.Right}
+	case Return:
+		if term.Value != nil {
+			return []Value{term.Value}
+		}
+	}
+	return nil
+}
+
+// Block is a basic block, a sequence of instructions entered at its start
+// and left by its terminator.
+type Block struct {
+	Label  string
+	Instrs []Instr
+	Term   Term
+}
+
+// Successors returns the blocks the terminator of b continues at.
+func (b *Block) Successors() []*Block {
+	switch term := b.Term.(type) {
+	case Jump:
+		return []*Block{term.Target}
+	case Branch:
+		return []*Block{term.Then, term.Else}
+	}
+	return nil
+}
+
+// Func is the main program or a proc or fn. ID numbers it among the
+// functions of the program, Params and Result are the data slots the
+// arguments and the result are passed in. Blocks are in layout order, the
+// first one is the entry.
+type Func struct {
+	Name   string
+	ID     int
+	Params []Var
+	Result *Var
+	Blocks []*Block
+}
+
+// Data is a data label of the program with its initial bytes.
+type Data struct {
+	Name string
+	Init []int
+}
+
+// Program is a whole program. Temps counts the temporaries, which are
+// numbered from 1 across all functions.
+type Program struct {
+	Main  *Func
+	Funcs []*Func
+	Data  []Data
+	Temps int
+}
+
+func values(vs []Value) string {
+	s := make([]string, len(vs))
+	for i, v := range vs {
+		s[i] = v.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+// initString returns the initial bytes of a data label, a table filled with
+// a single value is written as value x size.
+func initString(init []int) string {
+	uniform := len(init) > 1
+	for _, value := range init {
+		uniform = uniform && value == init[0]
+	}
+	if uniform {
+		return fmt.Sprintf("%v x %v", init[0], len(init))
+	}
+
+	s := make([]string, len(init))
+	for i, value := range init {
+		s[i] = fmt.Sprint(value)
+	}
+	return strings.Join(s, ", ")
+}
+
+func (f *Func) String() string {
+	var b strings.Builder
+	params := make([]Value, len(f.Params))
+	for i, param := range f.Params {
+		params[i] = param
+	}
+	fmt.Fprintf(&b, "func %v(%v)", f.Name, values(params))
+	if f.Result != nil {
+		fmt.Fprintf(&b, " -> %v", f.Result)
+	}
+	b.WriteString(":\n")
+	for _, block := range f.Blocks {
+		fmt.Fprintf(&b, "%v:\n", block.Label)
+		for _, instr := range block.Instrs {
+			fmt.Fprintf(&b, "\t%v\n", instr)
+		}
+		fmt.Fprintf(&b, "\t%v\n", block.Term)
+	}
+	return b.String()
+}
+
+func (p *Program) String() string {
+	var b strings.Builder
+	for _, data := range p.Data {
+		fmt.Fprintf(&b, "data %v [%v]\n", data.Name, initString(data.Init))
+	}
+	for _, f := range append([]*Func{p.Main}, p.Funcs...) {
+		fmt.Fprintf(&b, "\n%v", f)
+	}
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package ir
+
+// Interval is the span of positions where a temporary holds a value still
+// to be read: it is written after position Start and read last at End. The
+// instructions and terminators of a function are numbered from 0 in their
+// layout order.
+type Interval struct {
+	Start, End int
+}
+
+// Overlaps reports whether two temporaries hold a value at the same time,
+// in which case they cannot share a data slot. An instruction reads its
+// operands before writing its destination, so an interval may start where
+// the other ends.
+func (i Interval) Overlaps(j Interval) bool {
+	return i.Start < j.End && j.Start < i.End
+}
+
+// LiveIntervals returns the interval of every temporary of f. The liveness
+// of the temporaries is computed across the blocks, so that an interval
+// covers the whole loop a temporary is live around.
+func LiveIntervals(f *Func) map[Temp]Interval {
+	start := map[*Block]int{}
+	end := map[*Block]int{}
+	uses := map[*Block]map[Temp]bool{}
+	defs := map[*Block]map[Temp]bool{}
+	intervals := map[Temp]Interval{}
+
+	extend := func(t Temp, from, to int) {
+		i, ok := intervals[t]
+		if !ok {
+			intervals[t] = Interval{from, to}
+			return
+		}
+		if from < i.Start {
+			i.Start = from
+		}
+		if to > i.End {
+			i.End = to
+		}
+		intervals[t] = i
+	}
+
+	pos := 0
+	for _, block := range f.Blocks {
+		start[block] = pos
+		uses[block], defs[block] = map[Temp]bool{}, map[Temp]bool{}
+		read := func(values []Value) {
+			for _, v := range values {
+				if t, ok := v.(Temp); ok {
+					extend(t, pos, pos)
+					if !defs[block][t] {
+						uses[block][t] = true
+					}
+				}
+			}
+		}
+
+		for _, instr := range block.Instrs {
+			read(Operands(instr))
+			if t, ok := Destination(instr).(Temp); ok {
+				extend(t, pos, pos)
+				defs[block][t] = true
+			}
+			pos++
+		}
+		read(TermOperands(block.Term))
+		end[block] = pos
+		pos++
+	}
+
+	liveIn := map[*Block]map[Temp]bool{}
+	liveOut := map[*Block]map[Temp]bool{}
+	for changed := true; changed; {
+		changed = false
+		for i := len(f.Blocks) - 1; i >= 0; i-- {
+			block := f.Blocks[i]
+			out := map[Temp]bool{}
+			for _, succ := range block.Successors() {
+				for t := range liveIn[succ] {
+					out[t] = true
+				}
+			}
+			in := map[Temp]bool{}
+			for t := range uses[block] {
+				in[t] = true
+			}
+			for t := range out {
+				if !defs[block][t] {
+					in[t] = true
+				}
+			}
+			if len(in) != len(liveIn[block]) || len(out) != len(liveOut[block]) {
+				changed = true
+			}
+			liveIn[block], liveOut[block] = in, out
+		}
+	}
+
+	for _, block := range f.Blocks {
+		for t := range liveIn[block] {
+			extend(t, start[block]-1, start[block])
+		}
+		for t := range liveOut[block] {
+			extend(t, end[block], end[block]+1)
+		}
+	}
+	return intervals
+}
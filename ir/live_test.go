@@ -0,0 +1,32 @@
+package ir
+
+import "testing"
+
+// TestLiveIntervalsAroundLoop lays out the body of a loop after its exit,
+// so that only the liveness across the back edge tells that a temporary
+// read after the loop holds its value while the body runs.
+func TestLiveIntervalsAroundLoop(t *testing.T) {
+	kept, counter := Temp{1}, Temp{2}
+	i := Var{"var_i"}
+	entry := &Block{Label: "entry0"}
+	head := &Block{Label: "startwhile1"}
+	exit := &Block{Label: "endwhile1"}
+	body := &Block{Label: "dowhile1"}
+
+	entry.Instrs = []Instr{Copy{kept, Input}}
+	entry.Term = Jump{head}
+	head.Term = Branch{Op: "<", Left: i, Right: Const{3}, Then: body, Else: exit}
+	exit.Instrs = []Instr{Copy{Output, kept}}
+	exit.Term = Halt{}
+	body.Instrs = []Instr{
+		BinOp{Op: "+", Dst: counter, Left: i, Right: Const{1}},
+		Copy{i, counter},
+	}
+	body.Term = Jump{head}
+	f := &Func{Name: "main", Blocks: []*Block{entry, head, exit, body}}
+
+	live := LiveIntervals(f)
+	if !live[kept].Overlaps(live[counter]) {
+		t.Errorf("%v live at %v and %v live at %v do not overlap", kept, live[kept], counter, live[counter])
+	}
+}
@@ -0,0 +1,486 @@
+package ir
+
+import (
+	"fmt"
+	"minicompiler/ast"
+)
+
+// loop holds the blocks that break and continue jump to.
+type loop struct {
+	breakBlock    *Block
+	continueBlock *Block
+}
+
+// lowerer builds the IR of a program. Instructions are appended to block,
+// the current block, which is laid out at the end of fn.
+type lowerer struct {
+	prog  *Program
+	funcs map[string]*Func
+	fn    *Func
+	block *Block
+	loops []loop
+
+	labelCount int
+}
+
+// Lower returns the IR of a program the semantic pass checked without
+// errors, which resolved every name to its data label. The errors of the
+// program are reported by the semantic pass alone, a tree it would reject
+// is a bug of the caller and makes Lower panic.
+func Lower(p *ast.Program) *Program {
+	l := &lowerer{prog: &Program{}, funcs: map[string]*Func{}}
+
+	bodies := map[*Func]*ast.BlockStatement{}
+	for _, stmt := range p.Statements {
+		switch decl := stmt.(type) {
+		case *ast.ProcStatement:
+			bodies[l.declareFunc(decl.Name, nil, false)] = decl.Block
+		case *ast.FnStatement:
+			bodies[l.declareFunc(decl.Name, decl.Params, true)] = decl.Block
+		}
+	}
+
+	l.prog.Main = &Func{Name: "main"}
+	l.startFunc(l.prog.Main)
+	for _, stmt := range p.Statements {
+		l.statement(stmt)
+	}
+	l.terminate(Halt{})
+
+	for _, f := range l.prog.Funcs {
+		l.startFunc(f)
+		l.statement(bodies[f])
+		if l.block != nil {
+			l.terminate(Return{})
+		}
+	}
+
+	return l.prog
+}
+
+// internalf reports a tree the semantic pass should have rejected.
+func internalf(format string, args ...interface{}) {
+	panic(fmt.Sprintf("ir: "+format, args...))
+}
+
+func (l *lowerer) newLabelNumber() int {
+	l.labelCount++
+	return l.labelCount
+}
+
+func (l *lowerer) newTemp() Temp {
+	l.prog.Temps++
+	return Temp{l.prog.Temps}
+}
+
+// newBlock returns a block to be laid out later with start, named after
+// its role and the number of the statement it belongs to.
+func (l *lowerer) newBlock(name string, id int) *Block {
+	return &Block{Label: fmt.Sprintf("%v%v", name, id)}
+}
+
+// start lays out b after the current block and makes it the current one.
+// The current block must be terminated, control falls through only by an
+// explicit jump to b.
+func (l *lowerer) start(b *Block) {
+	l.fn.Blocks = append(l.fn.Blocks, b)
+	l.block = b
+}
+
+// current returns the block to append to. The code following a terminator,
+// such as the statements after a break, is unreachable and goes to a new
+// block.
+func (l *lowerer) current() *Block {
+	if l.block == nil {
+		l.start(l.newBlock("dead", l.newLabelNumber()))
+	}
+	return l.block
+}
+
+// terminate ends the current block with t.
+func (l *lowerer) terminate(t Term) {
+	l.current().Term = t
+	l.block = nil
+}
+
+func (l *lowerer) emit(instr Instr) {
+	b := l.current()
+	b.Instrs = append(b.Instrs, instr)
+}
+
+// assign stores value into dst. A temporary computed by the last
+// instruction, which is the value of the expression just lowered and is
+// read nowhere else, is not copied: the instruction writes dst instead.
+func (l *lowerer) assign(dst, value Value) {
+	if tmp, ok := value.(Temp); ok && l.block != nil && len(l.block.Instrs) > 0 {
+		last := &l.block.Instrs[len(l.block.Instrs)-1]
+		if Destination(*last) == tmp {
+			*last = withDestination(*last, dst)
+			return
+		}
+	}
+	l.emit(Copy{dst, value})
+}
+
+// withDestination returns instr writing dst instead of its destination.
+func withDestination(instr Instr, dst Value) Instr {
+	switch instr := instr.(type) {
+	case Copy:
+		instr.Dst = dst
+		return instr
+	case BinOp:
+		instr.Dst = dst
+		return instr
+	case Load:
+		instr.Dst = dst
+		return instr
+	case Call:
+		instr.Dst = dst
+		return instr
+	}
+	return instr
+}
+
+// jump terminates the current block with a jump to b.
+func (l *lowerer) jump(b *Block) {
+	l.terminate(Jump{b})
+}
+
+func (l *lowerer) startFunc(f *Func) {
+	l.fn = f
+	l.loops = nil
+	l.start(&Block{Label: fmt.Sprintf("entry%v", f.ID)})
+}
+
+func (l *lowerer) declareFunc(name string, params []ast.Identifier, isFn bool) *Func {
+	if _, exists := l.funcs[name]; exists {
+		internalf("procedure %v declared twice", name)
+	}
+
+	f := &Func{Name: name, ID: l.newLabelNumber()}
+	for _, param := range params {
+		slot := l.varLocation(param)
+		f.Params = append(f.Params, slot)
+		l.prog.Data = append(l.prog.Data, Data{Name: slot.Name, Init: []int{0}})
+	}
+	if isFn {
+		f.Result = &Var{fmt.Sprintf("res_%v", f.ID)}
+		l.prog.Data = append(l.prog.Data, Data{Name: f.Result.Name, Init: []int{0}})
+	}
+
+	l.funcs[name] = f
+	l.prog.Funcs = append(l.prog.Funcs, f)
+	return f
+}
+
+// varLocation returns the data label holding a variable or a table, as
+// resolved by the semantic pass.
+func (l *lowerer) varLocation(ident ast.Identifier) Var {
+	if ident.Storage == "" {
+		internalf("unresolved identifier %v", ident.Value)
+	}
+	return Var{ident.Storage}
+}
+
+func (l *lowerer) statement(node ast.Statement) {
+	switch node := node.(type) {
+	case *ast.AssignStatement:
+		value := l.expression(node.Right)
+		dst := Value(Output)
+		if node.Left.Value != "output" {
+			dst = l.varLocation(node.Left)
+		}
+		l.assign(dst, value)
+	case *ast.AssignTabStatement:
+		values := l.operands(node.Index, node.Right)
+		index, value := values[0], values[1]
+		base := Value(Screen)
+		if node.Left.Value != "screen" {
+			base = l.varLocation(node.Left)
+		}
+		l.emit(Store{Base: base, Index: index, Src: value})
+	case *ast.InitStatement:
+		value := l.expression(node.Expr)
+		location := l.varLocation(ast.Identifier{Value: node.Location, Storage: node.Storage})
+		l.assign(location, value)
+		l.prog.Data = append(l.prog.Data, Data{Name: location.Name, Init: []int{0}})
+	case *ast.TabInitStatement:
+		location := l.varLocation(ast.Identifier{Value: node.Location, Storage: node.Storage})
+		init := make([]int, node.Size)
+		for i := range init {
+			init[i] = node.DefaultValue
+		}
+		l.prog.Data = append(l.prog.Data, Data{Name: location.Name, Init: init})
+	case *ast.WaitStatement:
+		l.emit(Wait{node.Time})
+	case *ast.BlockStatement:
+		for _, stmt := range node.Statements {
+			l.statement(stmt)
+		}
+	case *ast.IfStatement:
+		l.ifStatement(node)
+	case *ast.MatchStatement:
+		l.matchStatement(node)
+	case *ast.WhileStatement:
+		id := l.newLabelNumber()
+		start, body, end := l.newBlock("startwhile", id), l.newBlock("dowhile", id), l.newBlock("endwhile", id)
+		l.jump(start)
+		l.start(start)
+		l.condition(node.Condition, body, end)
+		l.start(body)
+		l.loopBlock(node.Block, end, start)
+		l.jump(start)
+		l.start(end)
+	case *ast.ForStatement:
+		l.statement(node.Init)
+		id := l.newLabelNumber()
+		start, body, next, end := l.newBlock("startfor", id), l.newBlock("dofor", id), l.newBlock("nextfor", id), l.newBlock("endfor", id)
+		l.jump(start)
+		l.start(start)
+		l.condition(node.Condition, body, end)
+		l.start(body)
+		l.loopBlock(node.Block, end, next)
+		l.jump(next)
+		l.start(next)
+		l.statement(node.Post)
+		l.jump(start)
+		l.start(end)
+	case *ast.BreakStatement:
+		if len(l.loops) == 0 {
+			internalf("break outside of a loop")
+		}
+		l.jump(l.loops[len(l.loops)-1].breakBlock)
+	case *ast.ContinueStatement:
+		if len(l.loops) == 0 {
+			internalf("continue outside of a loop")
+		}
+		l.jump(l.loops[len(l.loops)-1].continueBlock)
+	case *ast.CallStatement:
+		l.call(node.Name, node.Args, nil)
+	case *ast.ReturnStatement:
+		if l.fn == l.prog.Main {
+			internalf("return outside of a procedure")
+		}
+		var value Value
+		if node.Value != nil {
+			value = l.expression(node.Value)
+		}
+		l.terminate(Return{value})
+	case *ast.ProcStatement, *ast.FnStatement:
+		// procedure bodies are lowered after the main program
+	case *ast.ConstStatement:
+		// constants are folded into literals by the semantic pass
+	}
+}
+
+func (l *lowerer) ifStatement(node *ast.IfStatement) {
+	id := l.newLabelNumber()
+	then, alt, end := l.newBlock("then", id), l.newBlock("else", id), l.newBlock("ifend", id)
+
+	l.condition(node.Condition, then, alt)
+	l.start(then)
+	l.statement(node.Block)
+	l.jump(end)
+	l.start(alt)
+	l.statement(node.Alternative)
+	l.jump(end)
+	l.start(end)
+}
+
+// matchStatement compares the subject against the pattern of each arm in
+// turn. The subject is evaluated once, and the semantic pass guarantees that
+// the patterns are constants so they cannot change it. A port gives a new
+// value at each read and is copied into a temporary first.
+func (l *lowerer) matchStatement(node *ast.MatchStatement) {
+	end := l.newBlock("matchend", l.newLabelNumber())
+	subject := l.expression(node.Subject)
+	if _, ok := subject.(Port); ok {
+		tmp := l.newTemp()
+		l.emit(Copy{tmp, subject})
+		subject = tmp
+	}
+
+	for _, arm := range node.Arms {
+		if arm.Pattern == nil {
+			l.statement(arm.Block)
+			break
+		}
+
+		id := l.newLabelNumber()
+		body, next := l.newBlock("matcharm", id), l.newBlock("matchnext", id)
+		pattern := l.expression(arm.Pattern)
+		l.branch("==", subject, pattern, body, next)
+		l.start(body)
+		l.statement(arm.Block)
+		l.jump(end)
+		l.start(next)
+	}
+
+	l.jump(end)
+	l.start(end)
+}
+
+// loopBlock lowers the body of a loop with the blocks that break and
+// continue statements of the body jump to.
+func (l *lowerer) loopBlock(block *ast.BlockStatement, breakBlock, continueBlock *Block) {
+	l.loops = append(l.loops, loop{breakBlock: breakBlock, continueBlock: continueBlock})
+	l.statement(block)
+	l.loops = l.loops[:len(l.loops)-1]
+}
+
+// branch terminates the current block with a branch on a comparison.
+func (l *lowerer) branch(op string, left, right Value, then, alt *Block) {
+	l.terminate(Branch{Op: op, Left: left, Right: right, Then: then, Else: alt})
+}
+
+// condition terminates the current block with the branches to then when
+// the condition holds and to alt otherwise. Logical operators become
+// branch chains so that their right operand is only evaluated when needed.
+func (l *lowerer) condition(node ast.Expression, then, alt *Block) {
+	switch node := node.(type) {
+	case *ast.PrefixExpression:
+		l.condition(node.Right, alt, then)
+		return
+
+	case *ast.InfixExpression:
+		switch node.Operator {
+		case "&&":
+			next := l.newBlock("condskip", l.newLabelNumber())
+			l.condition(node.Left, next, alt)
+			l.start(next)
+			l.condition(node.Right, then, alt)
+			return
+		case "||":
+			next := l.newBlock("condskip", l.newLabelNumber())
+			l.condition(node.Left, then, next)
+			l.start(next)
+			l.condition(node.Right, then, alt)
+			return
+		case "==", "!=", "<", ">", "<=", ">=":
+			values := l.operands(node.Left, node.Right)
+			l.branch(node.Operator, values[0], values[1], then, alt)
+			return
+		}
+	}
+
+	// any other expression is true when it equals 1
+	l.branch("==", l.expression(node), Const{1}, then, alt)
+}
+
+// logical materializes the 0/1 value of a logical expression used outside
+// of a condition.
+func (l *lowerer) logical(node ast.Expression) Value {
+	id := l.newLabelNumber()
+	then, alt, end := l.newBlock("condtrue", id), l.newBlock("condfalse", id), l.newBlock("condend", id)
+	tmp := l.newTemp()
+
+	l.condition(node, then, alt)
+	l.start(then)
+	l.emit(Copy{tmp, Const{1}})
+	l.jump(end)
+	l.start(alt)
+	l.emit(Copy{tmp, Const{0}})
+	l.jump(end)
+	l.start(end)
+	return tmp
+}
+
+func (l *lowerer) expression(node ast.Expression) Value {
+	switch node := node.(type) {
+	case *ast.IntegerLiteral:
+		i, err := ast.ParseInteger(node.Value)
+		if err != nil {
+			internalf("invalid integer literal %v", node.Value)
+		}
+		return Const{i}
+	case *ast.Identifier:
+		switch node.Value {
+		case "input":
+			return Input
+		case "random":
+			return Random
+		}
+		return l.varLocation(*node)
+	case *ast.InfixExpression:
+		if node.Operator == "&&" || node.Operator == "||" {
+			return l.logical(node)
+		}
+		values := l.operands(node.Left, node.Right)
+		tmp := l.newTemp()
+		l.emit(BinOp{Op: node.Operator, Dst: tmp, Left: values[0], Right: values[1]})
+		return tmp
+	case *ast.PrefixExpression:
+		return l.logical(node)
+	case *ast.TabExpression:
+		index := l.expression(node.Index)
+		tmp := l.newTemp()
+		l.emit(Load{Dst: tmp, Base: l.varLocation(node.Ident), Index: index})
+		return tmp
+	case *ast.CallExpression:
+		if f := l.funcs[node.Name]; f != nil && f.Result == nil {
+			internalf("procedure %v does not return a value", node.Name)
+		}
+		tmp := l.newTemp()
+		l.call(node.Name, node.Args, tmp)
+		return tmp
+	}
+	internalf("unexpected expression %T", node)
+	return nil
+}
+
+// call emits a call of the procedure name, storing its result into dst
+// unless dst is nil.
+func (l *lowerer) call(name string, args []ast.Expression, dst Value) {
+	f, ok := l.funcs[name]
+	if !ok {
+		internalf("call to undeclared procedure %v", name)
+	}
+	if len(args) != len(f.Params) {
+		internalf("%v expects %v arguments, got %v", name, len(f.Params), len(args))
+	}
+
+	l.emit(Call{Dst: dst, Func: f, Args: l.operands(args...)})
+}
+
+// operands evaluates nodes from left to right. A variable or a port is
+// read where its value is used, so one followed by an operand calling a fn,
+// which may write the variable or read the port, is copied into a temporary
+// to keep the value it had when it was evaluated.
+func (l *lowerer) operands(nodes ...ast.Expression) []Value {
+	values := make([]Value, len(nodes))
+	for i, node := range nodes {
+		values[i] = l.expression(node)
+		switch values[i].(type) {
+		case Var, Port:
+			if hasCall(nodes[i+1:]...) {
+				tmp := l.newTemp()
+				l.emit(Copy{tmp, values[i]})
+				values[i] = tmp
+			}
+		}
+	}
+	return values
+}
+
+// hasCall reports whether one of nodes calls a fn.
+func hasCall(nodes ...ast.Expression) bool {
+	for _, node := range nodes {
+		switch node := node.(type) {
+		case *ast.CallExpression:
+			return true
+		case *ast.InfixExpression:
+			if hasCall(node.Left, node.Right) {
+				return true
+			}
+		case *ast.PrefixExpression:
+			if hasCall(node.Right) {
+				return true
+			}
+		case *ast.TabExpression:
+			if hasCall(node.Index) {
+				return true
+			}
+		}
+	}
+	return false
+}
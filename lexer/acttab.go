@@ -29,91 +29,91 @@ var ActTab = ActionTable{
 		Ignore: "!whitespace",
 	},
 	ActionRow{ // S2
-		Accept: 0,
+		Accept: 31,
 		Ignore: "",
 	},
 	ActionRow{ // S3
-		Accept: 21,
+		Accept: 32,
 		Ignore: "",
 	},
 	ActionRow{ // S4
-		Accept: 15,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S5
-		Accept: 16,
+		Accept: 6,
 		Ignore: "",
 	},
 	ActionRow{ // S6
-		Accept: 20,
+		Accept: 7,
 		Ignore: "",
 	},
 	ActionRow{ // S7
-		Accept: 18,
+		Accept: 33,
 		Ignore: "",
 	},
 	ActionRow{ // S8
-		Accept: 19,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S9
-		Accept: 0,
+		Accept: 11,
 		Ignore: "",
 	},
 	ActionRow{ // S10
-		Accept: 10,
+		Accept: 36,
 		Ignore: "",
 	},
 	ActionRow{ // S11
-		Accept: 10,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S12
-		Accept: 8,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 24,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 7,
+		Accept: 10,
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 5,
+		Accept: 39,
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 6,
+		Accept: 9,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 9,
+		Accept: 40,
 		Ignore: "",
 	},
 	ActionRow{ // S18
-		Accept: 11,
+		Accept: 14,
 		Ignore: "",
 	},
 	ActionRow{ // S19
-		Accept: 6,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S20
-		Accept: 6,
+		Accept: 15,
 		Ignore: "",
 	},
 	ActionRow{ // S21
-		Accept: 6,
+		Accept: 16,
 		Ignore: "",
 	},
 	ActionRow{ // S22
-		Accept: 6,
+		Accept: 28,
 		Ignore: "",
 	},
 	ActionRow{ // S23
-		Accept: 3,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S24
@@ -121,31 +121,31 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S25
-		Accept: 23,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S26
-		Accept: 0,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S27
-		Accept: 0,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S28
-		Accept: 10,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S29
-		Accept: 22,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S30
-		Accept: 6,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S31
-		Accept: 6,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S32
@@ -153,51 +153,259 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S33
-		Accept: 6,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S34
-		Accept: 6,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S35
-		Accept: 0,
+		Accept: 38,
 		Ignore: "",
 	},
 	ActionRow{ // S36
-		Accept: -1,
-		Ignore: "!comment",
+		Accept: 30,
+		Ignore: "",
 	},
 	ActionRow{ // S37
-		Accept: 6,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S38
-		Accept: 6,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S39
-		Accept: 6,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S40
-		Accept: -1,
-		Ignore: "!comment",
+		Accept: 0,
+		Ignore: "",
 	},
 	ActionRow{ // S41
-		Accept: 17,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S42
-		Accept: 14,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S43
-		Accept: 6,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S44
-		Accept: 13,
+		Accept: 41,
+		Ignore: "",
+	},
+	ActionRow{ // S45
+		Accept: 37,
+		Ignore: "",
+	},
+	ActionRow{ // S46
+		Accept: 27,
+		Ignore: "",
+	},
+	ActionRow{ // S47
+		Accept: 42,
+		Ignore: "",
+	},
+	ActionRow{ // S48
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S49
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S50
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S51
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S52
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S53
+		Accept: 5,
+		Ignore: "",
+	},
+	ActionRow{ // S54
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S55
+		Accept: 25,
+		Ignore: "",
+	},
+	ActionRow{ // S56
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S57
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S58
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S59
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S60
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S61
+		Accept: 29,
+		Ignore: "",
+	},
+	ActionRow{ // S62
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S63
+		Accept: 34,
+		Ignore: "",
+	},
+	ActionRow{ // S64
+		Accept: 0,
+		Ignore: "",
+	},
+	ActionRow{ // S65
+		Accept: -1,
+		Ignore: "!comment",
+	},
+	ActionRow{ // S66
+		Accept: 34,
+		Ignore: "",
+	},
+	ActionRow{ // S67
+		Accept: 34,
+		Ignore: "",
+	},
+	ActionRow{ // S68
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S69
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S70
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S71
+		Accept: 20,
+		Ignore: "",
+	},
+	ActionRow{ // S72
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S73
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S74
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S75
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S76
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S77
+		Accept: -1,
+		Ignore: "!comment",
+	},
+	ActionRow{ // S78
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S79
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S80
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S81
+		Accept: 26,
+		Ignore: "",
+	},
+	ActionRow{ // S82
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S83
+		Accept: 3,
+		Ignore: "",
+	},
+	ActionRow{ // S84
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S85
+		Accept: 19,
+		Ignore: "",
+	},
+	ActionRow{ // S86
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S87
+		Accept: 21,
+		Ignore: "",
+	},
+	ActionRow{ // S88
+		Accept: 8,
+		Ignore: "",
+	},
+	ActionRow{ // S89
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S90
+		Accept: 17,
+		Ignore: "",
+	},
+	ActionRow{ // S91
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S92
+		Accept: 18,
+		Ignore: "",
+	},
+	ActionRow{ // S93
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S94
+		Accept: 23,
+		Ignore: "",
+	},
+	ActionRow{ // S95
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S96
+		Accept: 22,
 		Ignore: "",
 	},
 }
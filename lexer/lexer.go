@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 45
-	NumSymbols = 52
+	NumStates  = 97
+	NumSymbols = 124
 )
 
 type Lexer struct {
@@ -131,54 +131,126 @@ func (l *Lexer) Reset() {
 Lexer symbols:
 0: ';'
 1: '0'
-2: '='
-3: '+'
-4: '-'
-5: '*'
-6: '&'
-7: '{'
-8: '}'
-9: '@'
-10: '['
-11: ']'
-12: 'i'
-13: 'f'
-14: 'w'
-15: 'h'
-16: 'i'
-17: 'l'
-18: 'e'
-19: 'w'
-20: 'a'
-21: 'i'
-22: 't'
+2: '0'
+3: 'x'
+4: 'X'
+5: '0'
+6: 'b'
+7: 'B'
+8: '''
+9: '''
+10: '='
+11: '+'
+12: '-'
+13: '*'
+14: '&'
+15: '{'
+16: '}'
+17: 'p'
+18: 'r'
+19: 'o'
+20: 'c'
+21: 'f'
+22: 'n'
 23: '('
 24: ')'
-25: 'e'
-26: 'l'
-27: 's'
-28: 'e'
-29: '='
-30: '='
-31: '!'
-32: '='
-33: '<'
-34: '_'
-35: '/'
-36: '/'
-37: '\n'
-38: '/'
-39: '*'
-40: '*'
-41: '*'
-42: '/'
-43: ' '
-44: '\t'
-45: '\r'
-46: '\n'
-47: '1'-'9'
-48: 'a'-'z'
-49: 'A'-'Z'
-50: '0'-'9'
-51: .
+25: 'c'
+26: 'o'
+27: 'n'
+28: 's'
+29: 't'
+30: ','
+31: '@'
+32: '['
+33: ']'
+34: 'm'
+35: 'a'
+36: 't'
+37: 'c'
+38: 'h'
+39: 'w'
+40: 'h'
+41: 'i'
+42: 'l'
+43: 'e'
+44: 'w'
+45: 'a'
+46: 'i'
+47: 't'
+48: 'f'
+49: 'o'
+50: 'r'
+51: 'b'
+52: 'r'
+53: 'e'
+54: 'a'
+55: 'k'
+56: 'c'
+57: 'o'
+58: 'n'
+59: 't'
+60: 'i'
+61: 'n'
+62: 'u'
+63: 'e'
+64: 'r'
+65: 'e'
+66: 't'
+67: 'u'
+68: 'r'
+69: 'n'
+70: 'i'
+71: 'f'
+72: 'e'
+73: 'l'
+74: 's'
+75: 'e'
+76: '='
+77: '>'
+78: '_'
+79: '|'
+80: '|'
+81: '&'
+82: '&'
+83: '!'
+84: '='
+85: '='
+86: '!'
+87: '='
+88: '<'
+89: '>'
+90: '<'
+91: '='
+92: '>'
+93: '='
+94: '_'
+95: '0'
+96: '1'
+97: '\'
+98: 'n'
+99: 'r'
+100: 't'
+101: '0'
+102: '\'
+103: '''
+104: '/'
+105: '/'
+106: '\n'
+107: '/'
+108: '*'
+109: '*'
+110: '*'
+111: '/'
+112: ' '
+113: '\t'
+114: '\r'
+115: '\n'
+116: '1'-'9'
+117: 'a'-'z'
+118: 'A'-'Z'
+119: '0'-'9'
+120: '0'-'9'
+121: 'a'-'f'
+122: 'A'-'F'
+123: .
 */
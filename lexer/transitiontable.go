@@ -25,56 +25,84 @@ var TransTab = TransitionTable{
 			return 2
 		case r == 38: // ['&','&']
 			return 3
-		case r == 40: // ['(','(']
+		case r == 39: // [''',''']
 			return 4
-		case r == 41: // [')',')']
+		case r == 40: // ['(','(']
 			return 5
-		case r == 42: // ['*','*']
+		case r == 41: // [')',')']
 			return 6
-		case r == 43: // ['+','+']
+		case r == 42: // ['*','*']
 			return 7
-		case r == 45: // ['-','-']
+		case r == 43: // ['+','+']
 			return 8
-		case r == 47: // ['/','/']
+		case r == 44: // [',',',']
 			return 9
-		case r == 48: // ['0','0']
+		case r == 45: // ['-','-']
 			return 10
-		case 49 <= r && r <= 57: // ['1','9']
+		case r == 47: // ['/','/']
 			return 11
-		case r == 59: // [';',';']
+		case r == 48: // ['0','0']
 			return 12
-		case r == 60: // ['<','<']
+		case 49 <= r && r <= 57: // ['1','9']
 			return 13
-		case r == 61: // ['=','=']
+		case r == 59: // [';',';']
 			return 14
-		case r == 64: // ['@','@']
+		case r == 60: // ['<','<']
 			return 15
-		case 65 <= r && r <= 90: // ['A','Z']
+		case r == 61: // ['=','=']
 			return 16
-		case r == 91: // ['[','[']
+		case r == 62: // ['>','>']
 			return 17
-		case r == 93: // [']',']']
+		case r == 64: // ['@','@']
 			return 18
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 91: // ['[','[']
+			return 20
+		case r == 93: // [']',']']
+			return 21
 		case r == 95: // ['_','_']
+			return 22
+		case r == 97: // ['a','a']
+			return 19
+		case r == 98: // ['b','b']
+			return 23
+		case r == 99: // ['c','c']
+			return 24
+		case r == 100: // ['d','d']
 			return 19
-		case 97 <= r && r <= 100: // ['a','d']
-			return 16
 		case r == 101: // ['e','e']
-			return 20
-		case 102 <= r && r <= 104: // ['f','h']
-			return 16
+			return 25
+		case r == 102: // ['f','f']
+			return 26
+		case 103 <= r && r <= 104: // ['g','h']
+			return 19
 		case r == 105: // ['i','i']
-			return 21
-		case 106 <= r && r <= 118: // ['j','v']
-			return 16
+			return 27
+		case 106 <= r && r <= 108: // ['j','l']
+			return 19
+		case r == 109: // ['m','m']
+			return 28
+		case 110 <= r && r <= 111: // ['n','o']
+			return 19
+		case r == 112: // ['p','p']
+			return 29
+		case r == 113: // ['q','q']
+			return 19
+		case r == 114: // ['r','r']
+			return 30
+		case 115 <= r && r <= 118: // ['s','v']
+			return 19
 		case r == 119: // ['w','w']
-			return 22
+			return 31
 		case 120 <= r && r <= 122: // ['x','z']
-			return 16
+			return 19
 		case r == 123: // ['{','{']
-			return 23
+			return 32
+		case r == 124: // ['|','|']
+			return 33
 		case r == 125: // ['}','}']
-			return 24
+			return 34
 		}
 		return NoState
 	},
@@ -88,21 +116,26 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 25
+			return 35
 		}
 		return NoState
 	},
 	// S3
 	func(r rune) int {
 		switch {
+		case r == 38: // ['&','&']
+			return 36
 		}
 		return NoState
 	},
 	// S4
 	func(r rune) int {
 		switch {
+		case r == 92: // ['\','\']
+			return 37
+		default:
+			return 38
 		}
-		return NoState
 	},
 	// S5
 	func(r rune) int {
@@ -131,10 +164,6 @@ var TransTab = TransitionTable{
 	// S9
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 26
-		case r == 47: // ['/','/']
-			return 27
 		}
 		return NoState
 	},
@@ -147,54 +176,64 @@ var TransTab = TransitionTable{
 	// S11
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 28
+		case r == 42: // ['*','*']
+			return 39
+		case r == 47: // ['/','/']
+			return 40
 		}
 		return NoState
 	},
 	// S12
 	func(r rune) int {
 		switch {
+		case r == 66: // ['B','B']
+			return 41
+		case r == 88: // ['X','X']
+			return 42
+		case r == 98: // ['b','b']
+			return 41
+		case r == 120: // ['x','x']
+			return 42
 		}
 		return NoState
 	},
 	// S13
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
 		}
 		return NoState
 	},
 	// S14
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 29
 		}
 		return NoState
 	},
 	// S15
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 44
 		}
 		return NoState
 	},
 	// S16
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 30
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 16
+		case r == 61: // ['=','=']
+			return 45
+		case r == 62: // ['>','>']
+			return 46
 		}
 		return NoState
 	},
 	// S17
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 47
 		}
 		return NoState
 	},
@@ -208,49 +247,25 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
 			return 19
+		case r == 95: // ['_','_']
+			return 49
 		case 97 <= r && r <= 122: // ['a','z']
-			return 16
+			return 19
 		}
 		return NoState
 	},
 	// S20
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 30
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 107: // ['a','k']
-			return 16
-		case r == 108: // ['l','l']
-			return 31
-		case 109 <= r && r <= 122: // ['m','z']
-			return 16
 		}
 		return NoState
 	},
 	// S21
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 30
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 101: // ['a','e']
-			return 16
-		case r == 102: // ['f','f']
-			return 32
-		case 103 <= r && r <= 122: // ['g','z']
-			return 16
 		}
 		return NoState
 	},
@@ -258,69 +273,139 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 19
 		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
 			return 19
-		case r == 97: // ['a','a']
-			return 33
-		case 98 <= r && r <= 103: // ['b','g']
-			return 16
-		case r == 104: // ['h','h']
-			return 34
-		case 105 <= r && r <= 122: // ['i','z']
-			return 16
 		}
 		return NoState
 	},
 	// S23
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 113: // ['a','q']
+			return 19
+		case r == 114: // ['r','r']
+			return 50
+		case 115 <= r && r <= 122: // ['s','z']
+			return 19
 		}
 		return NoState
 	},
 	// S24
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 110: // ['a','n']
+			return 19
+		case r == 111: // ['o','o']
+			return 51
+		case 112 <= r && r <= 122: // ['p','z']
+			return 19
 		}
 		return NoState
 	},
 	// S25
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 107: // ['a','k']
+			return 19
+		case r == 108: // ['l','l']
+			return 52
+		case 109 <= r && r <= 122: // ['m','z']
+			return 19
 		}
 		return NoState
 	},
 	// S26
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 35
-		default:
-			return 26
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 109: // ['a','m']
+			return 19
+		case r == 110: // ['n','n']
+			return 53
+		case r == 111: // ['o','o']
+			return 54
+		case 112 <= r && r <= 122: // ['p','z']
+			return 19
 		}
+		return NoState
 	},
 	// S27
 	func(r rune) int {
 		switch {
-		case r == 10: // ['\n','\n']
-			return 36
-		default:
-			return 27
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 101: // ['a','e']
+			return 19
+		case r == 102: // ['f','f']
+			return 55
+		case 103 <= r && r <= 122: // ['g','z']
+			return 19
 		}
+		return NoState
 	},
 	// S28
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 28
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case r == 97: // ['a','a']
+			return 56
+		case 98 <= r && r <= 122: // ['b','z']
+			return 19
 		}
 		return NoState
 	},
 	// S29
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 113: // ['a','q']
+			return 19
+		case r == 114: // ['r','r']
+			return 57
+		case 115 <= r && r <= 122: // ['s','z']
+			return 19
 		}
 		return NoState
 	},
@@ -328,13 +413,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 19
 		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 100: // ['a','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 58
+		case 102 <= r && r <= 122: // ['f','z']
 			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 16
 		}
 		return NoState
 	},
@@ -342,204 +431,896 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 19
 		case r == 95: // ['_','_']
+			return 49
+		case r == 97: // ['a','a']
+			return 59
+		case 98 <= r && r <= 103: // ['b','g']
+			return 19
+		case r == 104: // ['h','h']
+			return 60
+		case 105 <= r && r <= 122: // ['i','z']
 			return 19
-		case 97 <= r && r <= 114: // ['a','r']
-			return 16
-		case r == 115: // ['s','s']
-			return 37
-		case 116 <= r && r <= 122: // ['t','z']
-			return 16
 		}
 		return NoState
 	},
 	// S32
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 30
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 122: // ['a','z']
-			return 16
 		}
 		return NoState
 	},
 	// S33
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 30
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 104: // ['a','h']
-			return 16
-		case r == 105: // ['i','i']
-			return 38
-		case 106 <= r && r <= 122: // ['j','z']
-			return 16
+		case r == 124: // ['|','|']
+			return 61
 		}
 		return NoState
 	},
 	// S34
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 30
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
-			return 19
-		case 97 <= r && r <= 104: // ['a','h']
-			return 16
-		case r == 105: // ['i','i']
-			return 39
+		}
+		return NoState
+	},
+	// S35
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S36
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S37
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 62
+		case r == 48: // ['0','0']
+			return 62
+		case r == 92: // ['\','\']
+			return 62
+		case r == 110: // ['n','n']
+			return 62
+		case r == 114: // ['r','r']
+			return 62
+		case r == 116: // ['t','t']
+			return 62
+		}
+		return NoState
+	},
+	// S38
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 63
+		}
+		return NoState
+	},
+	// S39
+	func(r rune) int {
+		switch {
+		case r == 42: // ['*','*']
+			return 64
+		default:
+			return 39
+		}
+	},
+	// S40
+	func(r rune) int {
+		switch {
+		case r == 10: // ['\n','\n']
+			return 65
+		default:
+			return 40
+		}
+	},
+	// S41
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 66
+		case r == 49: // ['1','1']
+			return 66
+		}
+		return NoState
+	},
+	// S42
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 67
+		case 65 <= r && r <= 70: // ['A','F']
+			return 67
+		case 97 <= r && r <= 102: // ['a','f']
+			return 67
+		}
+		return NoState
+	},
+	// S43
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		}
+		return NoState
+	},
+	// S44
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S45
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S46
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S47
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S48
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S49
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S50
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 100: // ['a','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 68
+		case 102 <= r && r <= 122: // ['f','z']
+			return 19
+		}
+		return NoState
+	},
+	// S51
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 109: // ['a','m']
+			return 19
+		case r == 110: // ['n','n']
+			return 69
+		case 111 <= r && r <= 122: // ['o','z']
+			return 19
+		}
+		return NoState
+	},
+	// S52
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 114: // ['a','r']
+			return 19
+		case r == 115: // ['s','s']
+			return 70
+		case 116 <= r && r <= 122: // ['t','z']
+			return 19
+		}
+		return NoState
+	},
+	// S53
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 113: // ['a','q']
+			return 19
+		case r == 114: // ['r','r']
+			return 71
+		case 115 <= r && r <= 122: // ['s','z']
+			return 19
+		}
+		return NoState
+	},
+	// S55
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S56
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 115: // ['a','s']
+			return 19
+		case r == 116: // ['t','t']
+			return 72
+		case 117 <= r && r <= 122: // ['u','z']
+			return 19
+		}
+		return NoState
+	},
+	// S57
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 110: // ['a','n']
+			return 19
+		case r == 111: // ['o','o']
+			return 73
+		case 112 <= r && r <= 122: // ['p','z']
+			return 19
+		}
+		return NoState
+	},
+	// S58
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 115: // ['a','s']
+			return 19
+		case r == 116: // ['t','t']
+			return 74
+		case 117 <= r && r <= 122: // ['u','z']
+			return 19
+		}
+		return NoState
+	},
+	// S59
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 104: // ['a','h']
+			return 19
+		case r == 105: // ['i','i']
+			return 75
 		case 106 <= r && r <= 122: // ['j','z']
-			return 16
+			return 19
+		}
+		return NoState
+	},
+	// S60
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 104: // ['a','h']
+			return 19
+		case r == 105: // ['i','i']
+			return 76
+		case 106 <= r && r <= 122: // ['j','z']
+			return 19
+		}
+		return NoState
+	},
+	// S61
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S62
+	func(r rune) int {
+		switch {
+		case r == 39: // [''',''']
+			return 63
+		}
+		return NoState
+	},
+	// S63
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S64
+	func(r rune) int {
+		switch {
+		case r == 42: // ['*','*']
+			return 64
+		case r == 47: // ['/','/']
+			return 77
+		default:
+			return 39
+		}
+	},
+	// S65
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S66
+	func(r rune) int {
+		switch {
+		case r == 48: // ['0','0']
+			return 66
+		case r == 49: // ['1','1']
+			return 66
+		}
+		return NoState
+	},
+	// S67
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 67
+		case 65 <= r && r <= 70: // ['A','F']
+			return 67
+		case 97 <= r && r <= 102: // ['a','f']
+			return 67
+		}
+		return NoState
+	},
+	// S68
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case r == 97: // ['a','a']
+			return 78
+		case 98 <= r && r <= 122: // ['b','z']
+			return 19
+		}
+		return NoState
+	},
+	// S69
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 114: // ['a','r']
+			return 19
+		case r == 115: // ['s','s']
+			return 79
+		case r == 116: // ['t','t']
+			return 80
+		case 117 <= r && r <= 122: // ['u','z']
+			return 19
+		}
+		return NoState
+	},
+	// S70
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 100: // ['a','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 81
+		case 102 <= r && r <= 122: // ['f','z']
+			return 19
+		}
+		return NoState
+	},
+	// S71
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
 		}
 		return NoState
 	},
-	// S35
+	// S72
 	func(r rune) int {
 		switch {
-		case r == 42: // ['*','*']
-			return 35
-		case r == 47: // ['/','/']
-			return 40
-		default:
-			return 26
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 98: // ['a','b']
+			return 19
+		case r == 99: // ['c','c']
+			return 82
+		case 100 <= r && r <= 122: // ['d','z']
+			return 19
 		}
+		return NoState
 	},
-	// S36
+	// S73
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 98: // ['a','b']
+			return 19
+		case r == 99: // ['c','c']
+			return 83
+		case 100 <= r && r <= 122: // ['d','z']
+			return 19
 		}
 		return NoState
 	},
-	// S37
+	// S74
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 19
 		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 116: // ['a','t']
+			return 19
+		case r == 117: // ['u','u']
+			return 84
+		case 118 <= r && r <= 122: // ['v','z']
 			return 19
-		case 97 <= r && r <= 100: // ['a','d']
-			return 16
-		case r == 101: // ['e','e']
-			return 41
-		case 102 <= r && r <= 122: // ['f','z']
-			return 16
 		}
 		return NoState
 	},
-	// S38
+	// S75
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
 			return 19
+		case r == 95: // ['_','_']
+			return 49
 		case 97 <= r && r <= 115: // ['a','s']
-			return 16
+			return 19
 		case r == 116: // ['t','t']
-			return 42
+			return 85
 		case 117 <= r && r <= 122: // ['u','z']
-			return 16
+			return 19
 		}
 		return NoState
 	},
-	// S39
+	// S76
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
-		case r == 95: // ['_','_']
 			return 19
+		case r == 95: // ['_','_']
+			return 49
 		case 97 <= r && r <= 107: // ['a','k']
-			return 16
+			return 19
 		case r == 108: // ['l','l']
-			return 43
+			return 86
 		case 109 <= r && r <= 122: // ['m','z']
-			return 16
+			return 19
 		}
 		return NoState
 	},
-	// S40
+	// S77
 	func(r rune) int {
 		switch {
 		}
 		return NoState
 	},
-	// S41
+	// S78
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 106: // ['a','j']
+			return 19
+		case r == 107: // ['k','k']
+			return 87
+		case 108 <= r && r <= 122: // ['l','z']
+			return 19
+		}
+		return NoState
+	},
+	// S79
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 115: // ['a','s']
+			return 19
+		case r == 116: // ['t','t']
+			return 88
+		case 117 <= r && r <= 122: // ['u','z']
+			return 19
+		}
+		return NoState
+	},
+	// S80
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
 		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 104: // ['a','h']
+			return 19
+		case r == 105: // ['i','i']
+			return 89
+		case 106 <= r && r <= 122: // ['j','z']
+			return 19
+		}
+		return NoState
+	},
+	// S81
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 19
+		case r == 95: // ['_','_']
+			return 49
 		case 97 <= r && r <= 122: // ['a','z']
-			return 16
+			return 19
 		}
 		return NoState
 	},
-	// S42
+	// S82
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 19
 		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 103: // ['a','g']
+			return 19
+		case r == 104: // ['h','h']
+			return 90
+		case 105 <= r && r <= 122: // ['i','z']
+			return 19
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 19
+		case r == 95: // ['_','_']
+			return 49
 		case 97 <= r && r <= 122: // ['a','z']
-			return 16
+			return 19
 		}
 		return NoState
 	},
-	// S43
+	// S84
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 113: // ['a','q']
+			return 19
+		case r == 114: // ['r','r']
+			return 91
+		case 115 <= r && r <= 122: // ['s','z']
+			return 19
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
 		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 19
+		case r == 95: // ['_','_']
+			return 49
 		case 97 <= r && r <= 100: // ['a','d']
-			return 16
+			return 19
 		case r == 101: // ['e','e']
-			return 44
+			return 92
 		case 102 <= r && r <= 122: // ['f','z']
-			return 16
+			return 19
 		}
 		return NoState
 	},
-	// S44
+	// S87
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 30
+			return 48
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 16
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
 		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 109: // ['a','m']
+			return 19
+		case r == 110: // ['n','n']
+			return 93
+		case 111 <= r && r <= 122: // ['o','z']
 			return 19
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
 		case 97 <= r && r <= 122: // ['a','z']
-			return 16
+			return 19
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 109: // ['a','m']
+			return 19
+		case r == 110: // ['n','n']
+			return 94
+		case 111 <= r && r <= 122: // ['o','z']
+			return 19
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 116: // ['a','t']
+			return 19
+		case r == 117: // ['u','u']
+			return 95
+		case 118 <= r && r <= 122: // ['v','z']
+			return 19
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
+		}
+		return NoState
+	},
+	// S95
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 100: // ['a','d']
+			return 19
+		case r == 101: // ['e','e']
+			return 96
+		case 102 <= r && r <= 122: // ['f','z']
+			return 19
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 48
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 19
+		case r == 95: // ['_','_']
+			return 49
+		case 97 <= r && r <= 122: // ['a','z']
+			return 19
 		}
 		return NoState
 	},
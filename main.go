@@ -1,32 +1,70 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
 	"minicompiler/ast"
 	"minicompiler/cmd"
-	"minicompiler/gen"
+	"minicompiler/compiler"
+	"minicompiler/diag"
+	"minicompiler/format"
 	"minicompiler/lexer"
 	"minicompiler/mif_parser"
-	"minicompiler/parser"
+	"minicompiler/sim"
+	"minicompiler/token"
 	"os"
-	"regexp"
+	"path/filepath"
+	"strings"
 )
 
 func checkError(e error) {
 	if e != nil {
-		panic(e)
+		fmt.Fprintf(os.Stderr, "minicompiler: error: %v\n", e)
+		os.Exit(1)
 	}
 }
 
-func readFile(filepath string) (string, error) {
-	buffer, err := os.ReadFile(filepath)
-	if err != nil {
-		return "", err
+// report prints the diagnostics of the compilation and stops when one of
+// them is an error. Only the errors are printed in quiet mode.
+func report(opts cmd.Options, input []byte, diags []diag.Diagnostic) {
+	if opts.Quiet {
+		var errs []diag.Diagnostic
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				errs = append(errs, d)
+			}
+		}
+		diags = errs
+	}
+
+	filename := opts.Inputpath
+	if filename == cmd.Stdio {
+		filename = "<stdin>"
 	}
+	diag.Print(os.Stderr, filename, input, diags)
+	if diag.HasErrors(diags) {
+		os.Exit(1)
+	}
+}
 
-	return string(buffer), nil
+func readFile(filepath string) ([]byte, error) {
+	if filepath == cmd.Stdio {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(filepath)
 }
 
-func writeFile(filepath, content string) error {
+func writeFile(filepath string, content []byte) error {
+	if filepath == cmd.Stdio {
+		_, err := os.Stdout.Write(content)
+		return err
+	}
+
 	f, err := os.Create(filepath)
 	if err != nil {
 		return err
@@ -34,40 +72,177 @@ func writeFile(filepath, content string) error {
 
 	defer f.Close()
 
-	_, err = f.WriteString(content)
+	_, err = f.Write(content)
 	return err
 }
 
-func Parse(input string) *ast.Program {
-	l := lexer.NewLexer([]byte(input))
-	p := parser.NewParser()
-	node, err := p.Parse(l)
-	checkError(err)
-	program, _ := node.(*ast.Program)
+// tokens returns the tokens of input, one per line with their position.
+func tokens(input []byte) []byte {
+	var b bytes.Buffer
+	l := lexer.NewLexer(input)
+	for tok := l.Scan(); tok.Type != token.EOF; tok = l.Scan() {
+		fmt.Fprintf(&b, "%v:%v\t%v\t%q\n", tok.Line, tok.Column, token.TokMap.Id(tok.Type), tok.Lit)
+	}
+	return b.Bytes()
+}
+
+// isAst reports whether the input is a syntax tree in the JSON form of
+// --emit=ast rather than source.
+func isAst(opts cmd.Options) bool {
+	return filepath.Ext(opts.Inputpath) == ".json"
+}
 
+// parse returns the syntax tree of the input.
+func parse(opts cmd.Options, input []byte) *ast.Program {
+	if isAst(opts) {
+		program, err := ast.Unmarshal(input)
+		checkError(err)
+		return program
+	}
+
+	program, diags := compiler.Parse(input)
+	report(opts, input, diags)
 	return program
 }
 
-func main() {
-	cmpOptions, err := cmd.GetCompileOptions()
-	checkError(err)
+// compile runs the pipeline on the input, the diagnostics about a syntax
+// tree are printed without source lines.
+func compile(opts cmd.Options, input []byte, copts compiler.Options) *compiler.Result {
+	if isAst(opts) {
+		result, diags := compiler.CompileProgram(parse(opts, input), copts)
+		report(opts, nil, diags)
+		return result
+	}
 
-	input, err := readFile(cmpOptions.Inputpath)
+	result, diags := compiler.Compile(input, copts)
+	report(opts, input, diags)
+	return result
+}
+
+// output writes content to path, telling so in verbose mode.
+func output(opts cmd.Options, path string, content []byte) {
+	checkError(writeFile(path, content))
+	if opts.Verbose && path != cmd.Stdio {
+		fmt.Fprintf(os.Stderr, "\twrote %v (%v bytes)\n", path, len(content))
+	}
+}
+
+func build(opts cmd.Options, input []byte) {
+	var content, mif []byte
+	var err error
+	switch opts.Emit {
+	case cmd.EmitTokens:
+		content = tokens(input)
+	case cmd.EmitAst:
+		content, err = json.MarshalIndent(parse(opts, input), "", "    ")
+		checkError(err)
+		content = append(content, '\n')
+	default:
+		result := compile(opts, input, compiler.Options{})
+		switch opts.Emit {
+		case cmd.EmitIR:
+			content = []byte(result.IR.String())
+		case cmd.EmitAsm:
+			content = []byte(result.Asm)
+		case cmd.EmitMif:
+			content = []byte(result.Mif)
+		case cmd.EmitBin:
+			content, err = mif_parser.Binary(result.Mif)
+			checkError(err)
+		}
+		mif = []byte(result.Mif)
+	}
+
+	output(opts, opts.Outputpath, content)
+	if opts.MifOutputpath != "" {
+		output(opts, opts.MifOutputpath, mif)
+	}
+}
+
+// run executes a program, compiling it first unless it is a MIF file, and
+// prints the bytes it wrote at the output address.
+func run(opts cmd.Options, input []byte) {
+	mif := string(input)
+	if filepath.Ext(opts.Inputpath) != ".mif" {
+		mif = compile(opts, input, compiler.Options{}).Mif
+	}
+
+	m, err := sim.LoadMif(mif)
 	checkError(err)
-	program := Parse(input)
-	reg := regexp.MustCompile(`\..*?$`)
-	mifFileName := reg.ReplaceAllString(cmpOptions.Inputpath, ".mif")
+	values := opts.Input
+	m.Input = func() uint8 {
+		if len(values) == 0 {
+			return 0
+		}
+		value := values[0]
+		values = values[1:]
+		return value
+	}
+	random := rand.New(rand.NewSource(opts.Seed))
+	m.Random = func() uint8 {
+		return uint8(random.Intn(256))
+	}
 
-	asmCode := gen.GenWrapper(program)
+	err = m.Run(opts.MaxSteps)
+	for _, value := range m.Output {
+		fmt.Println(value)
+	}
+	if opts.Screen {
+		for row := 0; row < sim.ScreenSize/screenWidth; row++ {
+			line := make([]string, screenWidth)
+			for col := range line {
+				line[col] = fmt.Sprintf("%02X", m.Screen[row*screenWidth+col])
+			}
+			fmt.Println(strings.Join(line, " "))
+		}
+	}
+	checkError(err)
+	if opts.Verbose {
+		fmt.Fprintf(os.Stderr, "\thalted after %v steps\n", m.Steps)
+	}
+}
 
-	//if cmpOptions.AssemblyOutput {
-	writeFile(cmpOptions.Outputpath, asmCode.String())
+// screenWidth is the number of cells of a row of the screen.
+const screenWidth = 15
 
-	mifCode := mif_parser.CompileToMif(asmCode.String())
-	writeFile(mifFileName, mifCode.String())
-	//}
+func main() {
+	opts, err := cmd.GetOptions()
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	checkError(err)
 
-	/*js, err := json.MarshalIndent(program, "", "    ")
+	input, err := readFile(opts.Inputpath)
 	checkError(err)
-	fmt.Printf("\n%s\n", js)*/
+
+	switch opts.Command {
+	case "build":
+		build(opts, input)
+		if !opts.Quiet {
+			fmt.Fprintln(os.Stderr, "\tCompilation Successful")
+		}
+	case "check":
+		compile(opts, input, compiler.Options{CheckOnly: true})
+		if !opts.Quiet {
+			fmt.Fprintln(os.Stderr, "\tCheck Successful")
+		}
+	case "run":
+		run(opts, input)
+	case "fmt":
+		var formatted []byte
+		if isAst(opts) {
+			formatted = format.Program(parse(opts, input))
+		} else {
+			var diags []diag.Diagnostic
+			formatted, diags = format.Source(input)
+			report(opts, input, diags)
+		}
+		if !opts.Write || !bytes.Equal(formatted, input) {
+			output(opts, opts.Outputpath, formatted)
+		}
+	case "disasm":
+		asm, err := mif_parser.Disassemble(string(input))
+		checkError(err)
+		output(opts, opts.Outputpath, []byte(asm))
+	}
 }
@@ -3,7 +3,9 @@ package mif_parser
 import (
 	"bytes"
 	"fmt"
+	"minicompiler/diag"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -39,10 +41,10 @@ var opcode map[string]string = map[string]string{
 	"MOV R0, R3":         "17",
 }
 
-func check(e error) {
-	if e != nil {
-		panic(e)
-	}
+// errorf returns an error about the assembly line at index n, the assembly
+// has no position in the source so the diagnostic is not located.
+func errorf(n int, format string, args ...interface{}) diag.Diagnostic {
+	return diag.Diagnostic{Severity: diag.Error, Msg: fmt.Sprintf("assembly line %v: %v", n+1, fmt.Sprintf(format, args...))}
 }
 
 func isEmpty(line string) bool {
@@ -58,7 +60,7 @@ func write(b *bytes.Buffer, code string, args ...interface{}) {
 	b.WriteString(fmt.Sprintf(code, args...))
 }
 
-func CompileToMif(asmContent string) bytes.Buffer {
+func opcodeRegexps() map[*regexp.Regexp]string {
 	regmap := map[*regexp.Regexp]string{}
 	for s, op := range opcode {
 		s = strings.ReplaceAll(s, "[", `\[`)
@@ -68,12 +70,12 @@ func CompileToMif(asmContent string) bytes.Buffer {
 
 		regmap[regexp.MustCompile(s)] = op
 	}
-	var b bytes.Buffer
-	//entête fichier mif :
-	entete := "DEPTH=8192;\nWIDTH=24;\n\nADDRESS_RADIX=HEX;\nDATA_RADIX=HEX;\n\nCONTENT\nBEGIN\n"
-	write(&b, "%v", entete)
-	content := asmContent
-	lines := strings.Split(content, "\n")
+	return regmap
+}
+
+// layout maps every label and data label of the assembly lines to its
+// address, written as 4 hexadecimal digits.
+func layout(lines []string, regmap map[*regexp.Regexp]string) map[string]string {
 	lineMap := map[string]string{}
 	i := 0
 	for _, line := range lines {
@@ -102,17 +104,43 @@ func CompileToMif(asmContent string) bytes.Buffer {
 		}
 
 	}
+	return lineMap
+}
 
-	i = 0
-	for _, line := range lines {
+// Symbols returns the address of every label and data label of the
+// assembly.
+func Symbols(asmContent string) map[string]int {
+	symbols := map[string]int{}
+	for name, addr := range layout(strings.Split(asmContent, "\n"), opcodeRegexps()) {
+		value, _ := strconv.ParseInt(addr, 16, 32)
+		symbols[name] = int(value)
+	}
+	return symbols
+}
+
+func CompileToMif(asmContent string) (bytes.Buffer, []diag.Diagnostic) {
+	var diags []diag.Diagnostic
+	regmap := opcodeRegexps()
+	var b bytes.Buffer
+	//entête fichier mif :
+	entete := "DEPTH=8192;\nWIDTH=24;\n\nADDRESS_RADIX=HEX;\nDATA_RADIX=HEX;\n\nCONTENT\nBEGIN\n"
+	write(&b, "%v", entete)
+	content := asmContent
+	lines := strings.Split(content, "\n")
+	lineMap := layout(lines, regmap)
+
+	i := 0
+	for n, line := range lines {
 
 		line = strings.Trim(line, " ")
+		known := isEmpty(line) || label.MatchString(line) || variable.MatchString(line)
 
 		//case of operation
 
 		for reg, op := range regmap {
 
 			if reg.MatchString(line) {
+				known = true
 				g := reg.FindStringSubmatch(line)
 				if len(g) > 1 {
 					//numerotation ligne + opcode
@@ -128,6 +156,9 @@ func CompileToMif(asmContent string) bytes.Buffer {
 						param = fmt.Sprintf("%4v", strings.TrimLeft(g[1], "0x"))
 						param = strings.ReplaceAll(param, " ", "0")
 					} else {
+						if _, ok := lineMap[g[1]]; !ok {
+							diags = append(diags, errorf(n, "undefined symbol %v in %q", g[1], line))
+						}
 						param = fmt.Sprintf("%v", lineMap[g[1]])
 						param = strings.ReplaceAll(param, " ", "0")
 					}
@@ -153,6 +184,10 @@ func CompileToMif(asmContent string) bytes.Buffer {
 			}
 		}
 
+		if !known {
+			diags = append(diags, errorf(n, "unknown instruction %q", line))
+		}
+
 		//case of variable
 		if g := variable.FindStringSubmatch(line); !isEmpty(line) && len(g) > 0 {
 			//case of tab
@@ -194,6 +229,113 @@ func CompileToMif(asmContent string) bytes.Buffer {
 	}
 	eof := "END"
 	write(&b, "%v", eof)
-	fmt.Println("\tCompilation Successful")
-	return b
+	return b, diags
+}
+
+// Words returns the memory image of a MIF file written by CompileToMif,
+// from address 0 to the last address written. It is the reader of the MIF
+// files shared by the disassembler and the simulator.
+func Words(mifContent string) ([]uint32, error) {
+	var words []uint32
+	for _, line := range strings.Split(mifContent, "\n") {
+		sep := strings.Index(line, " : ")
+		end := strings.Index(line, ";")
+		if sep < 0 || end < sep {
+			continue
+		}
+
+		addr, err := strconv.ParseUint(strings.TrimSpace(line[:sep]), 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address in %q", line)
+		}
+		word, err := strconv.ParseUint(strings.TrimSpace(line[sep+3:end]), 16, 24)
+		if err != nil {
+			return nil, fmt.Errorf("invalid word in %q", line)
+		}
+
+		for len(words) <= int(addr) {
+			words = append(words, 0)
+		}
+		words[addr] = uint32(word)
+	}
+	return words, nil
+}
+
+// Binary returns the memory image of a MIF file written by CompileToMif as
+// raw words, 3 bytes big-endian each.
+func Binary(mifContent string) ([]byte, error) {
+	words, err := Words(mifContent)
+	if err != nil {
+		return nil, err
+	}
+
+	bin := make([]byte, 0, 3*len(words))
+	for _, word := range words {
+		bin = append(bin, byte(word>>16), byte(word>>8), byte(word))
+	}
+	return bin, nil
+}
+
+// Disassemble turns the memory image of a MIF file back into assembly that
+// CompileToMif assembles to the same image. Branch targets are labelled
+// locN and the words that are no instruction become data labelled dat_N,
+// N being the address, and the addresses of those data are named.
+func Disassemble(mifContent string) (string, error) {
+	words, err := Words(mifContent)
+	if err != nil {
+		return "", err
+	}
+
+	mnemonics := map[uint32]string{}
+	for s, op := range opcode {
+		code, _ := strconv.ParseUint(op, 16, 8)
+		mnemonics[uint32(code)] = s
+	}
+
+	// decode returns the instruction of a word, "" when the word cannot
+	// be written as one
+	decode := func(word uint32) string {
+		s, ok := mnemonics[word>>16]
+		param := word & 0xFFFF
+		switch {
+		case !ok:
+			return ""
+		case strings.Contains(s, "label"):
+			if int(param) >= len(words) {
+				return ""
+			}
+			return strings.Replace(s, "label", fmt.Sprintf("loc%v", param), 1)
+		case strings.Contains(s, "#param"):
+			return strings.Replace(s, "#param", fmt.Sprintf("#0x%X", param), 1)
+		case param != 0:
+			return ""
+		}
+		return s
+	}
+
+	targets := map[int]bool{}
+	for _, word := range words {
+		if s := decode(word); strings.Contains(mnemonics[word>>16], "label") && s != "" {
+			targets[int(word&0xFFFF)] = true
+		}
+	}
+
+	var b bytes.Buffer
+	for addr, word := range words {
+		if targets[addr] {
+			write(&b, "loc%v\n", addr)
+		}
+
+		s := decode(word)
+		if s == "" {
+			write(&b, "dat_%v DCB 0x%X\n", addr, word)
+			continue
+		}
+		// MOV R1 loads addresses, name those of the data of the image
+		if param := int(word & 0xFFFF); word>>16 == 0x01 && param < len(words) && decode(words[param]) == "" {
+			s = fmt.Sprintf("MOV R1, #dat_%v", param)
+		}
+		write(&b, "%v\n", s)
+	}
+	return b.String(), nil
 }
@@ -12,33 +12,51 @@ type (
 
 var actionTab = actionTable{
 	actionRow{ // S0
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(3), // $, reduce: Statements
+			reduce(2), // $, reduce: Program
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			reduce(3), // @, reduce: Statements
-			reduce(3), // identifier, reduce: Statements
+			shift(5),  // proc
+			shift(6),  // identifier
+			shift(7),  // fn
+			nil,       // (
+			nil,       // )
+			shift(8),  // const
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			shift(11), // @
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			reduce(3), // if, reduce: Statements
-			reduce(3), // while, reduce: Statements
-			reduce(3), // wait, reduce: Statements
-			nil,       // (
-			nil,       // )
+			shift(13), // match
+			shift(14), // while
+			shift(15), // wait
+			shift(16), // for
+			shift(17), // break
+			shift(18), // continue
+			shift(19), // return
+			shift(20), // error
+			shift(21), // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S1
@@ -47,148 +65,238 @@ var actionTab = actionTable{
 			nil,          // INVALID
 			accept(true), // $
 			nil,          // empty
-			nil,          // lbrace
-			nil,          // rbrace
-			nil,          // @
+			nil,          // proc
 			nil,          // identifier
+			nil,          // fn
+			nil,          // (
+			nil,          // )
+			nil,          // const
 			nil,          // assign
 			nil,          // terminator
+			nil,          // ,
+			nil,          // lbrace
+			nil,          // rbrace
+			nil,          // @
 			nil,          // [
-			nil,          // intLit
 			nil,          // ]
-			nil,          // if
+			nil,          // match
 			nil,          // while
 			nil,          // wait
-			nil,          // (
-			nil,          // )
+			nil,          // for
+			nil,          // break
+			nil,          // continue
+			nil,          // return
+			nil,          // error
+			nil,          // if
 			nil,          // else
+			nil,          // =>
+			nil,          // _
+			nil,          // ||
+			nil,          // &&
+			nil,          // !
+			nil,          // and
+			nil,          // mul
+			nil,          // intLit
 			nil,          // plus
 			nil,          // minus
-			nil,          // mul
-			nil,          // and
 			nil,          // ==
 			nil,          // !=
 			nil,          // <
+			nil,          // >
+			nil,          // <=
+			nil,          // >=
 		},
 	},
 	actionRow{ // S2
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(1), // $, reduce: Program
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			shift(4),  // @
-			shift(5),  // identifier
+			shift(5),  // proc
+			shift(6),  // identifier
+			shift(7),  // fn
+			nil,       // (
+			nil,       // )
+			shift(8),  // const
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			shift(11), // @
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			shift(6),  // if
-			shift(7),  // while
-			shift(8),  // wait
-			nil,       // (
-			nil,       // )
+			shift(13), // match
+			shift(14), // while
+			shift(15), // wait
+			shift(16), // for
+			shift(17), // break
+			shift(18), // continue
+			shift(19), // return
+			shift(20), // error
+			shift(21), // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S3
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(2), // $, reduce: Statements
+			reduce(5), // $, reduce: TopStatements
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			reduce(2), // @, reduce: Statements
-			reduce(2), // identifier, reduce: Statements
+			reduce(5), // proc, reduce: TopStatements
+			reduce(5), // identifier, reduce: TopStatements
+			reduce(5), // fn, reduce: TopStatements
+			nil,       // (
+			nil,       // )
+			reduce(5), // const, reduce: TopStatements
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			reduce(5), // @, reduce: TopStatements
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			reduce(2), // if, reduce: Statements
-			reduce(2), // while, reduce: Statements
-			reduce(2), // wait, reduce: Statements
-			nil,       // (
-			nil,       // )
+			reduce(5), // match, reduce: TopStatements
+			reduce(5), // while, reduce: TopStatements
+			reduce(5), // wait, reduce: TopStatements
+			reduce(5), // for, reduce: TopStatements
+			reduce(5), // break, reduce: TopStatements
+			reduce(5), // continue, reduce: TopStatements
+			reduce(5), // return, reduce: TopStatements
+			reduce(5), // error, reduce: TopStatements
+			reduce(5), // if, reduce: TopStatements
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S4
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,      // INVALID
-			nil,      // $
-			nil,      // empty
-			nil,      // lbrace
-			nil,      // rbrace
-			nil,      // @
-			shift(9), // identifier
-			nil,      // assign
-			nil,      // terminator
-			nil,      // [
-			nil,      // intLit
-			nil,      // ]
-			nil,      // if
-			nil,      // while
-			nil,      // wait
-			nil,      // (
-			nil,      // )
-			nil,      // else
-			nil,      // plus
-			nil,      // minus
-			nil,      // mul
-			nil,      // and
-			nil,      // ==
-			nil,      // !=
-			nil,      // <
+			nil,       // INVALID
+			reduce(6), // $, reduce: TopStatements
+			nil,       // empty
+			reduce(6), // proc, reduce: TopStatements
+			reduce(6), // identifier, reduce: TopStatements
+			reduce(6), // fn, reduce: TopStatements
+			nil,       // (
+			nil,       // )
+			reduce(6), // const, reduce: TopStatements
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			reduce(6), // @, reduce: TopStatements
+			nil,       // [
+			nil,       // ]
+			reduce(6), // match, reduce: TopStatements
+			reduce(6), // while, reduce: TopStatements
+			reduce(6), // wait, reduce: TopStatements
+			reduce(6), // for, reduce: TopStatements
+			reduce(6), // break, reduce: TopStatements
+			reduce(6), // continue, reduce: TopStatements
+			reduce(6), // return, reduce: TopStatements
+			reduce(6), // error, reduce: TopStatements
+			reduce(6), // if, reduce: TopStatements
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S5
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			shift(24), // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			nil,       // identifier
-			shift(10), // assign
-			nil,       // terminator
-			shift(11), // [
-			nil,       // intLit
+			nil,       // [
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S6
@@ -197,58 +305,94 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			shift(27), // (
+			nil,       // )
+			nil,       // const
+			shift(28), // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(12), // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			shift(14), // intLit
+			shift(29), // [
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S7
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			shift(30), // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(12), // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(14), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S8
@@ -257,28 +401,46 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			shift(32), // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			shift(17), // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S9
@@ -287,28 +449,46 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			shift(33), // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			nil,       // identifier
-			shift(18), // assign
-			nil,       // terminator
-			shift(19), // [
-			nil,       // intLit
+			nil,       // [
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S10
@@ -317,28 +497,46 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			shift(34), // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(20), // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(22), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S11
@@ -347,178 +545,286 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			shift(35), // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(24), // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(26), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S12
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // $
+			reduce(26), // $, reduce: Statement
 			nil,        // empty
-			reduce(18), // lbrace, reduce: Term
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
+			reduce(26), // proc, reduce: Statement
+			reduce(26), // identifier, reduce: Statement
+			reduce(26), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(26), // const, reduce: Statement
 			nil,        // assign
 			nil,        // terminator
-			shift(28),  // [
-			nil,        // intLit
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(26), // @, reduce: Statement
+			nil,        // [
 			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
+			reduce(26), // match, reduce: Statement
+			reduce(26), // while, reduce: Statement
+			reduce(26), // wait, reduce: Statement
+			reduce(26), // for, reduce: Statement
+			reduce(26), // break, reduce: Statement
+			reduce(26), // continue, reduce: Statement
+			reduce(26), // return, reduce: Statement
+			reduce(26), // error, reduce: Statement
+			reduce(26), // if, reduce: Statement
 			nil,        // else
-			reduce(18), // plus, reduce: Term
-			reduce(18), // minus, reduce: Term
-			reduce(18), // mul, reduce: Term
-			reduce(18), // and, reduce: Term
-			reduce(18), // ==, reduce: Term
-			reduce(18), // !=, reduce: Term
-			reduce(18), // <, reduce: Term
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S13
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
-			shift(30), // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
 			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S14
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			reduce(16), // lbrace, reduce: Term
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			nil,        // terminator
-			nil,        // [
-			nil,        // intLit
-			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
-			nil,        // else
-			reduce(16), // plus, reduce: Term
-			reduce(16), // minus, reduce: Term
-			reduce(16), // mul, reduce: Term
-			reduce(16), // and, reduce: Term
-			reduce(16), // ==, reduce: Term
-			reduce(16), // !=, reduce: Term
-			reduce(16), // <, reduce: Term
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S15
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			reduce(15), // lbrace, reduce: Expression
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			nil,        // terminator
-			nil,        // [
-			nil,        // intLit
-			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
-			nil,        // else
-			reduce(15), // plus, reduce: Expression
-			reduce(15), // minus, reduce: Expression
-			reduce(15), // mul, reduce: Expression
-			reduce(15), // and, reduce: Expression
-			reduce(15), // ==, reduce: Expression
-			reduce(15), // !=, reduce: Expression
-			reduce(15), // <, reduce: Expression
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			shift(51), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S16
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
-			shift(40), // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
+			nil,       // proc
+			shift(52), // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			shift(55), // @
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
 			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S17
@@ -527,28 +833,46 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			shift(56), // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(41), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S18
@@ -557,28 +881,46 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			shift(57), // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(20), // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(22), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S19
@@ -587,238 +929,382 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			shift(61), // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(43), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S20
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(40), // terminator, reduce: BadStatement
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			reduce(18), // terminator, reduce: Term
-			shift(44),  // [
-			nil,        // intLit
+			nil,        // [
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			reduce(18), // plus, reduce: Term
-			reduce(18), // minus, reduce: Term
-			reduce(18), // mul, reduce: Term
-			reduce(18), // and, reduce: Term
-			reduce(18), // ==, reduce: Term
-			reduce(18), // !=, reduce: Term
-			reduce(18), // <, reduce: Term
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S21
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			shift(45), // terminator
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
 			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S22
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			reduce(16), // terminator, reduce: Term
-			nil,        // [
-			nil,        // intLit
-			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
-			nil,        // else
-			reduce(16), // plus, reduce: Term
-			reduce(16), // minus, reduce: Term
-			reduce(16), // mul, reduce: Term
-			reduce(16), // and, reduce: Term
-			reduce(16), // ==, reduce: Term
-			reduce(16), // !=, reduce: Term
-			reduce(16), // <, reduce: Term
+			nil,       // INVALID
+			reduce(3), // $, reduce: TopStatements
+			nil,       // empty
+			reduce(3), // proc, reduce: TopStatements
+			reduce(3), // identifier, reduce: TopStatements
+			reduce(3), // fn, reduce: TopStatements
+			nil,       // (
+			nil,       // )
+			reduce(3), // const, reduce: TopStatements
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			reduce(3), // @, reduce: TopStatements
+			nil,       // [
+			nil,       // ]
+			reduce(3), // match, reduce: TopStatements
+			reduce(3), // while, reduce: TopStatements
+			reduce(3), // wait, reduce: TopStatements
+			reduce(3), // for, reduce: TopStatements
+			reduce(3), // break, reduce: TopStatements
+			reduce(3), // continue, reduce: TopStatements
+			reduce(3), // return, reduce: TopStatements
+			reduce(3), // error, reduce: TopStatements
+			reduce(3), // if, reduce: TopStatements
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S23
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			reduce(15), // terminator, reduce: Expression
-			nil,        // [
-			nil,        // intLit
-			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
-			nil,        // else
-			reduce(15), // plus, reduce: Expression
-			reduce(15), // minus, reduce: Expression
-			reduce(15), // mul, reduce: Expression
-			reduce(15), // and, reduce: Expression
-			reduce(15), // ==, reduce: Expression
-			reduce(15), // !=, reduce: Expression
-			reduce(15), // <, reduce: Expression
-		},
-	},
-	actionRow{ // S24
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			nil,        // terminator
-			shift(47),  // [
-			nil,        // intLit
-			reduce(18), // ], reduce: Term
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
-			nil,        // else
-			reduce(18), // plus, reduce: Term
-			reduce(18), // minus, reduce: Term
-			reduce(18), // mul, reduce: Term
-			reduce(18), // and, reduce: Term
-			reduce(18), // ==, reduce: Term
-			reduce(18), // !=, reduce: Term
-			reduce(18), // <, reduce: Term
-		},
-	},
-	actionRow{ // S25
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			nil,       // $
+			reduce(4), // $, reduce: TopStatements
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
+			reduce(4), // proc, reduce: TopStatements
+			reduce(4), // identifier, reduce: TopStatements
+			reduce(4), // fn, reduce: TopStatements
+			nil,       // (
+			nil,       // )
+			reduce(4), // const, reduce: TopStatements
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			reduce(4), // @, reduce: TopStatements
 			nil,       // [
+			nil,       // ]
+			reduce(4), // match, reduce: TopStatements
+			reduce(4), // while, reduce: TopStatements
+			reduce(4), // wait, reduce: TopStatements
+			reduce(4), // for, reduce: TopStatements
+			reduce(4), // break, reduce: TopStatements
+			reduce(4), // continue, reduce: TopStatements
+			reduce(4), // return, reduce: TopStatements
+			reduce(4), // error, reduce: TopStatements
+			reduce(4), // if, reduce: TopStatements
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
 			nil,       // intLit
-			shift(48), // ]
-			nil,       // if
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S24
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			shift(74), // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
 			nil,       // while
 			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S25
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
 			nil,       // (
 			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			shift(74), // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S26
-		canRecover: false,
+		canRecover: true,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
+			nil,        // proc
 			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			reduce(40), // lbrace, reduce: BadStatement
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
-			reduce(16), // ], reduce: Term
-			nil,        // if
+			nil,        // ]
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			reduce(16), // plus, reduce: Term
-			reduce(16), // minus, reduce: Term
-			reduce(16), // mul, reduce: Term
-			reduce(16), // and, reduce: Term
-			reduce(16), // ==, reduce: Term
-			reduce(16), // !=, reduce: Term
-			reduce(16), // <, reduce: Term
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S27
@@ -827,28 +1313,46 @@ var actionTab = actionTable{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			shift(76),  // identifier
+			nil,        // fn
+			shift(77),  // (
+			reduce(17), // ), reduce: Arguments
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			nil,        // terminator
 			nil,        // [
-			nil,        // intLit
-			reduce(15), // ], reduce: Expression
-			nil,        // if
+			nil,        // ]
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			reduce(15), // plus, reduce: Expression
-			reduce(15), // minus, reduce: Expression
-			reduce(15), // mul, reduce: Expression
-			reduce(15), // and, reduce: Expression
-			reduce(15), // ==, reduce: Expression
-			reduce(15), // !=, reduce: Expression
-			reduce(15), // <, reduce: Expression
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(83),  // !
+			nil,        // and
+			nil,        // mul
+			shift(89),  // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S28
@@ -857,88 +1361,142 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(24), // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(26), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S29
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(13), // $, reduce: ElseBlock
+			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			reduce(13), // @, reduce: ElseBlock
-			reduce(13), // identifier, reduce: ElseBlock
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			reduce(13), // if, reduce: ElseBlock
-			reduce(13), // while, reduce: ElseBlock
-			reduce(13), // wait, reduce: ElseBlock
-			nil,        // (
-			nil,        // )
-			shift(52),  // else
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S30
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(3), // rbrace, reduce: Statements
-			reduce(3), // @, reduce: Statements
-			reduce(3), // identifier, reduce: Statements
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(3), // if, reduce: Statements
-			reduce(3), // while, reduce: Statements
-			reduce(3), // wait, reduce: Statements
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			shift(103), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S31
@@ -947,28 +1505,46 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			shift(12), // identifier
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			shift(74), // lbrace
+			nil,       // rbrace
+			nil,       // @
 			nil,       // [
-			shift(14), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S32
@@ -977,88 +1553,142 @@ var actionTab = actionTable{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(105), // assign
+			nil,        // terminator
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			reduce(19), // identifier, reduce: Operation
-			nil,        // assign
-			nil,        // terminator
 			nil,        // [
-			reduce(19), // intLit, reduce: Operation
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S33
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // $
+			reduce(39), // $, reduce: Statement
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			reduce(20), // identifier, reduce: Operation
+			reduce(39), // proc, reduce: Statement
+			reduce(39), // identifier, reduce: Statement
+			reduce(39), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(39), // const, reduce: Statement
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(39), // @, reduce: Statement
 			nil,        // [
-			reduce(20), // intLit, reduce: Operation
 			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
+			reduce(39), // match, reduce: Statement
+			reduce(39), // while, reduce: Statement
+			reduce(39), // wait, reduce: Statement
+			reduce(39), // for, reduce: Statement
+			reduce(39), // break, reduce: Statement
+			reduce(39), // continue, reduce: Statement
+			reduce(39), // return, reduce: Statement
+			reduce(39), // error, reduce: Statement
+			reduce(39), // if, reduce: Statement
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S34
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // $
+			reduce(24), // $, reduce: Statement
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			reduce(21), // identifier, reduce: Operation
+			reduce(24), // proc, reduce: Statement
+			reduce(24), // identifier, reduce: Statement
+			reduce(24), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(24), // const, reduce: Statement
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(24), // @, reduce: Statement
 			nil,        // [
-			reduce(21), // intLit, reduce: Operation
 			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
+			reduce(24), // match, reduce: Statement
+			reduce(24), // while, reduce: Statement
+			reduce(24), // wait, reduce: Statement
+			reduce(24), // for, reduce: Statement
+			reduce(24), // break, reduce: Statement
+			reduce(24), // continue, reduce: Statement
+			reduce(24), // return, reduce: Statement
+			reduce(24), // error, reduce: Statement
+			reduce(24), // if, reduce: Statement
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S35
@@ -1067,28 +1697,46 @@ var actionTab = actionTable{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(106), // assign
+			nil,        // terminator
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			reduce(22), // identifier, reduce: Operation
-			nil,        // assign
-			nil,        // terminator
-			nil,        // [
-			reduce(22), // intLit, reduce: Operation
+			shift(107), // [
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S36
@@ -1097,28 +1745,46 @@ var actionTab = actionTable{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			reduce(23), // identifier, reduce: Operation
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			shift(108), // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
-			nil,        // [
-			reduce(23), // intLit, reduce: Operation
+			nil,        // ,
+			reduce(69), // lbrace, reduce: Term
+			nil,        // rbrace
+			nil,        // @
+			shift(109), // [
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			nil,        // plus
-			nil,        // minus
-			nil,        // mul
-			nil,        // and
-			nil,        // ==
-			nil,        // !=
-			nil,        // <
+			nil,        // =>
+			nil,        // _
+			reduce(69), // ||, reduce: Term
+			reduce(69), // &&, reduce: Term
+			nil,        // !
+			reduce(69), // and, reduce: Term
+			reduce(69), // mul, reduce: Term
+			nil,        // intLit
+			reduce(69), // plus, reduce: Term
+			reduce(69), // minus, reduce: Term
+			reduce(69), // ==, reduce: Term
+			reduce(69), // !=, reduce: Term
+			reduce(69), // <, reduce: Term
+			reduce(69), // >, reduce: Term
+			reduce(69), // <=, reduce: Term
+			reduce(69), // >=, reduce: Term
 		},
 	},
 	actionRow{ // S37
@@ -1127,28 +1793,46 @@ var actionTab = actionTable{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			reduce(24), // identifier, reduce: Operation
-			nil,        // assign
-			nil,        // terminator
 			nil,        // [
-			reduce(24), // intLit, reduce: Operation
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S38
@@ -1157,118 +1841,190 @@ var actionTab = actionTable{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			reduce(25), // identifier, reduce: Operation
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			shift(122), // lbrace
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			reduce(25), // intLit, reduce: Operation
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(123), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S39
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(8), // $, reduce: Statement
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			reduce(8), // @, reduce: Statement
-			reduce(8), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(8), // if, reduce: Statement
-			reduce(8), // while, reduce: Statement
-			reduce(8), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(124), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S40
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(3), // rbrace, reduce: Statements
-			reduce(3), // @, reduce: Statements
-			reduce(3), // identifier, reduce: Statements
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(3), // if, reduce: Statements
-			reduce(3), // while, reduce: Statements
-			reduce(3), // wait, reduce: Statements
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(54), // lbrace, reduce: Expression
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(54), // ||, reduce: Expression
+			shift(125), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S41
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			shift(56), // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(56), // lbrace, reduce: Conjunction
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(56), // ||, reduce: Conjunction
+			reduce(56), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 	actionRow{ // S42
@@ -1277,2278 +2033,18142 @@ var actionTab = actionTable{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			shift(57), // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
 			nil,       // )
-			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
-		},
-	},
-	actionRow{ // S43
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
+			nil,       // const
 			nil,       // assign
 			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			shift(58), // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S44
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(24), // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(26), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
-	actionRow{ // S45
+	actionRow{ // S43
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(9), // $, reduce: Statement
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			reduce(9), // @, reduce: Statement
-			reduce(9), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(9), // if, reduce: Statement
-			reduce(9), // while, reduce: Statement
-			reduce(9), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(58), // lbrace, reduce: Negation
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(58), // ||, reduce: Negation
+			reduce(58), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			shift(128), // ==
+			shift(129), // !=
+			shift(130), // <
+			shift(131), // >
+			shift(132), // <=
+			shift(133), // >=
+		},
+	},
+	actionRow{ // S44
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(60), // lbrace, reduce: Comparison
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(60), // ||, reduce: Comparison
+			reduce(60), // &&, reduce: Comparison
+			nil,        // !
+			shift(134), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(60), // ==, reduce: Comparison
+			reduce(60), // !=, reduce: Comparison
+			reduce(60), // <, reduce: Comparison
+			reduce(60), // >, reduce: Comparison
+			reduce(60), // <=, reduce: Comparison
+			reduce(60), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S45
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(62), // lbrace, reduce: BitwiseAnd
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(62), // ||, reduce: BitwiseAnd
+			reduce(62), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(62), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(62), // ==, reduce: BitwiseAnd
+			reduce(62), // !=, reduce: BitwiseAnd
+			reduce(62), // <, reduce: BitwiseAnd
+			reduce(62), // >, reduce: BitwiseAnd
+			reduce(62), // <=, reduce: BitwiseAnd
+			reduce(62), // >=, reduce: BitwiseAnd
 		},
 	},
 	actionRow{ // S46
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(64), // lbrace, reduce: Sum
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(64), // ||, reduce: Sum
+			reduce(64), // &&, reduce: Sum
+			nil,        // !
+			reduce(64), // and, reduce: Sum
+			shift(138), // mul
+			nil,        // intLit
+			reduce(64), // plus, reduce: Sum
+			reduce(64), // minus, reduce: Sum
+			reduce(64), // ==, reduce: Sum
+			reduce(64), // !=, reduce: Sum
+			reduce(64), // <, reduce: Sum
+			reduce(64), // >, reduce: Sum
+			reduce(64), // <=, reduce: Sum
+			reduce(64), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S47
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(66), // lbrace, reduce: Product
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(66), // ||, reduce: Product
+			reduce(66), // &&, reduce: Product
+			nil,        // !
+			reduce(66), // and, reduce: Product
+			reduce(66), // mul, reduce: Product
+			nil,        // intLit
+			reduce(66), // plus, reduce: Product
+			reduce(66), // minus, reduce: Product
+			reduce(66), // ==, reduce: Product
+			reduce(66), // !=, reduce: Product
+			reduce(66), // <, reduce: Product
+			reduce(66), // >, reduce: Product
+			reduce(66), // <=, reduce: Product
+			reduce(66), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S48
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(67), // lbrace, reduce: Term
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(67), // ||, reduce: Term
+			reduce(67), // &&, reduce: Term
+			nil,        // !
+			reduce(67), // and, reduce: Term
+			reduce(67), // mul, reduce: Term
+			nil,        // intLit
+			reduce(67), // plus, reduce: Term
+			reduce(67), // minus, reduce: Term
+			reduce(67), // ==, reduce: Term
+			reduce(67), // !=, reduce: Term
+			reduce(67), // <, reduce: Term
+			reduce(67), // >, reduce: Term
+			reduce(67), // <=, reduce: Term
+			reduce(67), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S49
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(74),  // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(123), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S50
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			shift(20), // identifier
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			shift(74), // lbrace
+			nil,       // rbrace
+			nil,       // @
 			nil,       // [
-			shift(22), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
-	actionRow{ // S47
+	actionRow{ // S51
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S52
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			shift(28), // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(24), // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			shift(26), // intLit
+			shift(29), // [
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
-	actionRow{ // S48
+	actionRow{ // S53
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
+			nil,       // proc
 			nil,       // identifier
-			shift(62), // assign
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			shift(74), // lbrace
+			nil,       // rbrace
+			nil,       // @
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
-	actionRow{ // S49
+	actionRow{ // S54
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			shift(24), // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			shift(26), // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(143), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S50
+	actionRow{ // S55
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			shift(64), // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(144), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S51
+	actionRow{ // S56
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(7), // $, reduce: Statement
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			reduce(7), // @, reduce: Statement
-			reduce(7), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(7), // if, reduce: Statement
-			reduce(7), // while, reduce: Statement
-			reduce(7), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			reduce(34), // $, reduce: Statement
+			nil,        // empty
+			reduce(34), // proc, reduce: Statement
+			reduce(34), // identifier, reduce: Statement
+			reduce(34), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(34), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(34), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(34), // match, reduce: Statement
+			reduce(34), // while, reduce: Statement
+			reduce(34), // wait, reduce: Statement
+			reduce(34), // for, reduce: Statement
+			reduce(34), // break, reduce: Statement
+			reduce(34), // continue, reduce: Statement
+			reduce(34), // return, reduce: Statement
+			reduce(34), // error, reduce: Statement
+			reduce(34), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S52
+	actionRow{ // S57
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(40), // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			reduce(35), // $, reduce: Statement
+			nil,        // empty
+			reduce(35), // proc, reduce: Statement
+			reduce(35), // identifier, reduce: Statement
+			reduce(35), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(35), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(35), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(35), // match, reduce: Statement
+			reduce(35), // while, reduce: Statement
+			reduce(35), // wait, reduce: Statement
+			reduce(35), // for, reduce: Statement
+			reduce(35), // break, reduce: Statement
+			reduce(35), // continue, reduce: Statement
+			reduce(35), // return, reduce: Statement
+			reduce(35), // error, reduce: Statement
+			reduce(35), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S53
+	actionRow{ // S58
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			shift(67), // rbrace
-			shift(68), // @
-			shift(69), // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			shift(70), // if
-			shift(71), // while
-			shift(72), // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			shift(145), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(69), // terminator, reduce: Term
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			shift(146), // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(69), // ||, reduce: Term
+			reduce(69), // &&, reduce: Term
+			nil,        // !
+			reduce(69), // and, reduce: Term
+			reduce(69), // mul, reduce: Term
+			nil,        // intLit
+			reduce(69), // plus, reduce: Term
+			reduce(69), // minus, reduce: Term
+			reduce(69), // ==, reduce: Term
+			reduce(69), // !=, reduce: Term
+			reduce(69), // <, reduce: Term
+			reduce(69), // >, reduce: Term
+			reduce(69), // <=, reduce: Term
+			reduce(69), // >=, reduce: Term
 		},
 	},
-	actionRow{ // S54
+	actionRow{ // S59
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			reduce(14), // lbrace, reduce: Expression
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			reduce(14), // plus, reduce: Expression
-			reduce(14), // minus, reduce: Expression
-			reduce(14), // mul, reduce: Expression
-			reduce(14), // and, reduce: Expression
-			reduce(14), // ==, reduce: Expression
-			reduce(14), // !=, reduce: Expression
-			reduce(14), // <, reduce: Expression
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S55
+	actionRow{ // S60
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			shift(73), // rbrace
-			shift(68), // @
-			shift(69), // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			shift(70), // if
-			shift(71), // while
-			shift(72), // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S56
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			shift(74), // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S57
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(5), // $, reduce: Statement
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			reduce(5), // @, reduce: Statement
-			reduce(5), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(5), // if, reduce: Statement
-			reduce(5), // while, reduce: Statement
-			reduce(5), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(148), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S58
+	actionRow{ // S61
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			shift(75), // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			reduce(37), // $, reduce: Statement
+			nil,        // empty
+			reduce(37), // proc, reduce: Statement
+			reduce(37), // identifier, reduce: Statement
+			reduce(37), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(37), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(37), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(37), // match, reduce: Statement
+			reduce(37), // while, reduce: Statement
+			reduce(37), // wait, reduce: Statement
+			reduce(37), // for, reduce: Statement
+			reduce(37), // break, reduce: Statement
+			reduce(37), // continue, reduce: Statement
+			reduce(37), // return, reduce: Statement
+			reduce(37), // error, reduce: Statement
+			reduce(37), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S59
+	actionRow{ // S62
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			shift(76), // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(54), // terminator, reduce: Expression
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(54), // ||, reduce: Expression
+			shift(150), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S60
+	actionRow{ // S63
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(56), // terminator, reduce: Conjunction
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			reduce(14), // terminator, reduce: Expression
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			reduce(14), // plus, reduce: Expression
-			reduce(14), // minus, reduce: Expression
-			reduce(14), // mul, reduce: Expression
-			reduce(14), // and, reduce: Expression
-			reduce(14), // ==, reduce: Expression
-			reduce(14), // !=, reduce: Expression
-			reduce(14), // <, reduce: Expression
+			nil,        // =>
+			nil,        // _
+			reduce(56), // ||, reduce: Conjunction
+			reduce(56), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S61
+	actionRow{ // S64
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
 			nil,       // assign
 			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			shift(77), // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
-		},
-	},
-	actionRow{ // S62
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(20), // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(22), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
-	actionRow{ // S63
+	actionRow{ // S65
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(58), // terminator, reduce: Negation
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			nil,        // terminator
 			nil,        // [
-			nil,        // intLit
-			reduce(14), // ], reduce: Expression
-			nil,        // if
+			nil,        // ]
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			reduce(14), // plus, reduce: Expression
-			reduce(14), // minus, reduce: Expression
-			reduce(14), // mul, reduce: Expression
-			reduce(14), // and, reduce: Expression
-			reduce(14), // ==, reduce: Expression
-			reduce(14), // !=, reduce: Expression
-			reduce(14), // <, reduce: Expression
+			nil,        // =>
+			nil,        // _
+			reduce(58), // ||, reduce: Negation
+			reduce(58), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			shift(128), // ==
+			shift(129), // !=
+			shift(130), // <
+			shift(131), // >
+			shift(132), // <=
+			shift(133), // >=
 		},
 	},
-	actionRow{ // S64
+	actionRow{ // S66
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			reduce(17), // lbrace, reduce: Term
-			nil,        // rbrace
-			nil,        // @
+			nil,        // proc
 			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
-			nil,        // terminator
+			reduce(60), // terminator, reduce: Comparison
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(60), // ||, reduce: Comparison
+			reduce(60), // &&, reduce: Comparison
+			nil,        // !
+			shift(153), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(60), // ==, reduce: Comparison
+			reduce(60), // !=, reduce: Comparison
+			reduce(60), // <, reduce: Comparison
+			reduce(60), // >, reduce: Comparison
+			reduce(60), // <=, reduce: Comparison
+			reduce(60), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S67
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
 			nil,        // (
 			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(62), // terminator, reduce: BitwiseAnd
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			reduce(17), // plus, reduce: Term
-			reduce(17), // minus, reduce: Term
-			reduce(17), // mul, reduce: Term
-			reduce(17), // and, reduce: Term
-			reduce(17), // ==, reduce: Term
-			reduce(17), // !=, reduce: Term
-			reduce(17), // <, reduce: Term
+			nil,        // =>
+			nil,        // _
+			reduce(62), // ||, reduce: BitwiseAnd
+			reduce(62), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(62), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(62), // ==, reduce: BitwiseAnd
+			reduce(62), // !=, reduce: BitwiseAnd
+			reduce(62), // <, reduce: BitwiseAnd
+			reduce(62), // >, reduce: BitwiseAnd
+			reduce(62), // <=, reduce: BitwiseAnd
+			reduce(62), // >=, reduce: BitwiseAnd
 		},
 	},
-	actionRow{ // S65
+	actionRow{ // S68
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(12), // $, reduce: ElseBlock
+			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(64), // terminator, reduce: Sum
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
-			reduce(12), // @, reduce: ElseBlock
-			reduce(12), // identifier, reduce: ElseBlock
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(64), // ||, reduce: Sum
+			reduce(64), // &&, reduce: Sum
+			nil,        // !
+			reduce(64), // and, reduce: Sum
+			shift(155), // mul
+			nil,        // intLit
+			reduce(64), // plus, reduce: Sum
+			reduce(64), // minus, reduce: Sum
+			reduce(64), // ==, reduce: Sum
+			reduce(64), // !=, reduce: Sum
+			reduce(64), // <, reduce: Sum
+			reduce(64), // >, reduce: Sum
+			reduce(64), // <=, reduce: Sum
+			reduce(64), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S69
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
-			nil,        // terminator
+			reduce(66), // terminator, reduce: Product
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(66), // ||, reduce: Product
+			reduce(66), // &&, reduce: Product
+			nil,        // !
+			reduce(66), // and, reduce: Product
+			reduce(66), // mul, reduce: Product
 			nil,        // intLit
+			reduce(66), // plus, reduce: Product
+			reduce(66), // minus, reduce: Product
+			reduce(66), // ==, reduce: Product
+			reduce(66), // !=, reduce: Product
+			reduce(66), // <, reduce: Product
+			reduce(66), // >, reduce: Product
+			reduce(66), // <=, reduce: Product
+			reduce(66), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S70
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(67), // terminator, reduce: Term
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
 			nil,        // ]
-			reduce(12), // if, reduce: ElseBlock
-			reduce(12), // while, reduce: ElseBlock
-			reduce(12), // wait, reduce: ElseBlock
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(67), // ||, reduce: Term
+			reduce(67), // &&, reduce: Term
+			nil,        // !
+			reduce(67), // and, reduce: Term
+			reduce(67), // mul, reduce: Term
+			nil,        // intLit
+			reduce(67), // plus, reduce: Term
+			reduce(67), // minus, reduce: Term
+			reduce(67), // ==, reduce: Term
+			reduce(67), // !=, reduce: Term
+			reduce(67), // <, reduce: Term
+			reduce(67), // >, reduce: Term
+			reduce(67), // <=, reduce: Term
+			reduce(67), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S71
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
 			nil,        // (
 			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(157), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(123), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S66
+	actionRow{ // S72
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(2), // rbrace, reduce: Statements
-			reduce(2), // @, reduce: Statements
-			reduce(2), // identifier, reduce: Statements
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(2), // if, reduce: Statements
-			reduce(2), // while, reduce: Statements
-			reduce(2), // wait, reduce: Statements
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(157), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S67
+	actionRow{ // S73
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
-			reduce(4), // $, reduce: StatementBlock
+			reduce(7), // $, reduce: Declaration
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			reduce(4), // @, reduce: StatementBlock
-			reduce(4), // identifier, reduce: StatementBlock
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(4), // if, reduce: StatementBlock
-			reduce(4), // while, reduce: StatementBlock
-			reduce(4), // wait, reduce: StatementBlock
+			reduce(7), // proc, reduce: Declaration
+			reduce(7), // identifier, reduce: Declaration
+			reduce(7), // fn, reduce: Declaration
 			nil,       // (
 			nil,       // )
-			reduce(4), // else, reduce: StatementBlock
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S68
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			shift(79), // identifier
+			reduce(7), // const, reduce: Declaration
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			reduce(7), // @, reduce: Declaration
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
+			reduce(7), // match, reduce: Declaration
+			reduce(7), // while, reduce: Declaration
+			reduce(7), // wait, reduce: Declaration
+			reduce(7), // for, reduce: Declaration
+			reduce(7), // break, reduce: Declaration
+			reduce(7), // continue, reduce: Declaration
+			reduce(7), // return, reduce: Declaration
+			reduce(7), // error, reduce: Declaration
+			reduce(7), // if, reduce: Declaration
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
-	actionRow{ // S69
+	actionRow{ // S74
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			shift(80), // assign
-			nil,       // terminator
-			shift(81), // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(21), // identifier, reduce: Statements
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(21), // rbrace, reduce: Statements
+			reduce(21), // @, reduce: Statements
+			nil,        // [
+			nil,        // ]
+			reduce(21), // match, reduce: Statements
+			reduce(21), // while, reduce: Statements
+			reduce(21), // wait, reduce: Statements
+			reduce(21), // for, reduce: Statements
+			reduce(21), // break, reduce: Statements
+			reduce(21), // continue, reduce: Statements
+			reduce(21), // return, reduce: Statements
+			reduce(21), // error, reduce: Statements
+			reduce(21), // if, reduce: Statements
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S70
+	actionRow{ // S75
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			shift(12), // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			shift(14), // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S71
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			shift(12), // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			shift(14), // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			reduce(10), // $, reduce: Declaration
+			nil,        // empty
+			reduce(10), // proc, reduce: Declaration
+			reduce(10), // identifier, reduce: Declaration
+			reduce(10), // fn, reduce: Declaration
+			nil,        // (
+			nil,        // )
+			reduce(10), // const, reduce: Declaration
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(10), // @, reduce: Declaration
+			nil,        // [
+			nil,        // ]
+			reduce(10), // match, reduce: Declaration
+			reduce(10), // while, reduce: Declaration
+			reduce(10), // wait, reduce: Declaration
+			reduce(10), // for, reduce: Declaration
+			reduce(10), // break, reduce: Declaration
+			reduce(10), // continue, reduce: Declaration
+			reduce(10), // return, reduce: Declaration
+			reduce(10), // error, reduce: Declaration
+			reduce(10), // if, reduce: Declaration
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S72
+	actionRow{ // S76
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			shift(84), // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			shift(160), // (
+			reduce(69), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(69), // ,, reduce: Term
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			shift(161), // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(69), // ||, reduce: Term
+			reduce(69), // &&, reduce: Term
+			nil,        // !
+			reduce(69), // and, reduce: Term
+			reduce(69), // mul, reduce: Term
+			nil,        // intLit
+			reduce(69), // plus, reduce: Term
+			reduce(69), // minus, reduce: Term
+			reduce(69), // ==, reduce: Term
+			reduce(69), // !=, reduce: Term
+			reduce(69), // <, reduce: Term
+			reduce(69), // >, reduce: Term
+			reduce(69), // <=, reduce: Term
+			reduce(69), // >=, reduce: Term
 		},
 	},
-	actionRow{ // S73
+	actionRow{ // S77
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(4), // $, reduce: StatementBlock
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			reduce(4), // @, reduce: StatementBlock
-			reduce(4), // identifier, reduce: StatementBlock
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(4), // if, reduce: StatementBlock
-			reduce(4), // while, reduce: StatementBlock
-			reduce(4), // wait, reduce: StatementBlock
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S74
+	actionRow{ // S78
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(10), // $, reduce: Statement
+			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			reduce(10), // @, reduce: Statement
-			reduce(10), // identifier, reduce: Statement
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(19), // ), reduce: ArgumentList
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			reduce(19), // ,, reduce: ArgumentList
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			reduce(10), // if, reduce: Statement
-			reduce(10), // while, reduce: Statement
-			reduce(10), // wait, reduce: Statement
-			nil,        // (
-			nil,        // )
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(163), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S75
+	actionRow{ // S79
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			shift(85), // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(164), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S76
+	actionRow{ // S80
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(16), // ), reduce: Arguments
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			shift(165), // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			reduce(17), // terminator, reduce: Term
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			reduce(17), // plus, reduce: Term
-			reduce(17), // minus, reduce: Term
-			reduce(17), // mul, reduce: Term
-			reduce(17), // and, reduce: Term
-			reduce(17), // ==, reduce: Term
-			reduce(17), // !=, reduce: Term
-			reduce(17), // <, reduce: Term
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S77
+	actionRow{ // S81
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
+			nil,        // proc
 			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(54), // ), reduce: Expression
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			reduce(54), // ,, reduce: Expression
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
-			reduce(17), // ], reduce: Term
-			nil,        // if
+			nil,        // ]
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			reduce(17), // plus, reduce: Term
-			reduce(17), // minus, reduce: Term
-			reduce(17), // mul, reduce: Term
-			reduce(17), // and, reduce: Term
-			reduce(17), // ==, reduce: Term
-			reduce(17), // !=, reduce: Term
-			reduce(17), // <, reduce: Term
+			nil,        // =>
+			nil,        // _
+			reduce(54), // ||, reduce: Expression
+			shift(166), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S78
+	actionRow{ // S82
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			shift(86), // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(56), // ), reduce: Conjunction
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(56), // ,, reduce: Conjunction
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(56), // ||, reduce: Conjunction
+			reduce(56), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S79
+	actionRow{ // S83
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			shift(87), // assign
-			nil,       // terminator
-			shift(88), // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
+			nil,       // proc
+			shift(76), // identifier
+			nil,       // fn
+			shift(77), // (
 			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S80
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			shift(20), // identifier
+			nil,       // const
 			nil,       // assign
 			nil,       // terminator
-			nil,       // [
-			shift(22), // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S81
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(24), // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(26), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(83), // !
+			nil,       // and
+			nil,       // mul
+			shift(89), // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
-		},
-	},
-	actionRow{ // S82
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(92), // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
-		},
-	},
-	actionRow{ // S83
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(94), // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
 	actionRow{ // S84
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			shift(95), // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(58), // ), reduce: Negation
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(58), // ,, reduce: Negation
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(58), // ||, reduce: Negation
+			reduce(58), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			shift(128), // ==
+			shift(129), // !=
+			shift(130), // <
+			shift(131), // >
+			shift(132), // <=
+			shift(133), // >=
 		},
 	},
 	actionRow{ // S85
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			shift(96), // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S86
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(11), // $, reduce: Statement
+			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			reduce(11), // @, reduce: Statement
-			reduce(11), // identifier, reduce: Statement
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(60), // ), reduce: Comparison
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			reduce(60), // ,, reduce: Comparison
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			reduce(11), // if, reduce: Statement
-			reduce(11), // while, reduce: Statement
-			reduce(11), // wait, reduce: Statement
-			nil,        // (
-			nil,        // )
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(60), // ||, reduce: Comparison
+			reduce(60), // &&, reduce: Comparison
+			nil,        // !
+			shift(169), // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
-			nil,        // ==
-			nil,        // !=
-			nil,        // <
+			reduce(60), // ==, reduce: Comparison
+			reduce(60), // !=, reduce: Comparison
+			reduce(60), // <, reduce: Comparison
+			reduce(60), // >, reduce: Comparison
+			reduce(60), // <=, reduce: Comparison
+			reduce(60), // >=, reduce: Comparison
 		},
 	},
-	actionRow{ // S87
+	actionRow{ // S86
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			shift(20), // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			shift(22), // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(62), // ), reduce: BitwiseAnd
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(62), // ,, reduce: BitwiseAnd
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(62), // ||, reduce: BitwiseAnd
+			reduce(62), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(62), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(62), // ==, reduce: BitwiseAnd
+			reduce(62), // !=, reduce: BitwiseAnd
+			reduce(62), // <, reduce: BitwiseAnd
+			reduce(62), // >, reduce: BitwiseAnd
+			reduce(62), // <=, reduce: BitwiseAnd
+			reduce(62), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S87
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(64), // ), reduce: Sum
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(64), // ,, reduce: Sum
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(64), // ||, reduce: Sum
+			reduce(64), // &&, reduce: Sum
+			nil,        // !
+			reduce(64), // and, reduce: Sum
+			shift(171), // mul
+			nil,        // intLit
+			reduce(64), // plus, reduce: Sum
+			reduce(64), // minus, reduce: Sum
+			reduce(64), // ==, reduce: Sum
+			reduce(64), // !=, reduce: Sum
+			reduce(64), // <, reduce: Sum
+			reduce(64), // >, reduce: Sum
+			reduce(64), // <=, reduce: Sum
+			reduce(64), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S88
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(66), // ), reduce: Product
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(66), // ,, reduce: Product
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(66), // ||, reduce: Product
+			reduce(66), // &&, reduce: Product
+			nil,        // !
+			reduce(66), // and, reduce: Product
+			reduce(66), // mul, reduce: Product
+			nil,        // intLit
+			reduce(66), // plus, reduce: Product
+			reduce(66), // minus, reduce: Product
+			reduce(66), // ==, reduce: Product
+			reduce(66), // !=, reduce: Product
+			reduce(66), // <, reduce: Product
+			reduce(66), // >, reduce: Product
+			reduce(66), // <=, reduce: Product
+			reduce(66), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S89
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(67), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(67), // ,, reduce: Term
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(67), // ||, reduce: Term
+			reduce(67), // &&, reduce: Term
+			nil,        // !
+			reduce(67), // and, reduce: Term
+			reduce(67), // mul, reduce: Term
+			nil,        // intLit
+			reduce(67), // plus, reduce: Term
+			reduce(67), // minus, reduce: Term
+			reduce(67), // ==, reduce: Term
+			reduce(67), // !=, reduce: Term
+			reduce(67), // <, reduce: Term
+			reduce(67), // >, reduce: Term
+			reduce(67), // <=, reduce: Term
+			reduce(67), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S90
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(42), // terminator, reduce: SimpleStatement
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S91
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			shift(172), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			shift(173), // [
+			reduce(69), // ], reduce: Term
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(69), // ||, reduce: Term
+			reduce(69), // &&, reduce: Term
+			nil,        // !
+			reduce(69), // and, reduce: Term
+			reduce(69), // mul, reduce: Term
+			nil,        // intLit
+			reduce(69), // plus, reduce: Term
+			reduce(69), // minus, reduce: Term
+			reduce(69), // ==, reduce: Term
+			reduce(69), // !=, reduce: Term
+			reduce(69), // <, reduce: Term
+			reduce(69), // >, reduce: Term
+			reduce(69), // <=, reduce: Term
+			reduce(69), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S92
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S93
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(175), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S94
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(54), // ], reduce: Expression
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(54), // ||, reduce: Expression
+			shift(177), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S95
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(56), // ], reduce: Conjunction
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(56), // ||, reduce: Conjunction
+			reduce(56), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S96
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S97
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(58), // ], reduce: Negation
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(58), // ||, reduce: Negation
+			reduce(58), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			shift(128), // ==
+			shift(129), // !=
+			shift(130), // <
+			shift(131), // >
+			shift(132), // <=
+			shift(133), // >=
+		},
+	},
+	actionRow{ // S98
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(60), // ], reduce: Comparison
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(60), // ||, reduce: Comparison
+			reduce(60), // &&, reduce: Comparison
+			nil,        // !
+			shift(180), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(60), // ==, reduce: Comparison
+			reduce(60), // !=, reduce: Comparison
+			reduce(60), // <, reduce: Comparison
+			reduce(60), // >, reduce: Comparison
+			reduce(60), // <=, reduce: Comparison
+			reduce(60), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S99
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(62), // ], reduce: BitwiseAnd
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(62), // ||, reduce: BitwiseAnd
+			reduce(62), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(62), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(62), // ==, reduce: BitwiseAnd
+			reduce(62), // !=, reduce: BitwiseAnd
+			reduce(62), // <, reduce: BitwiseAnd
+			reduce(62), // >, reduce: BitwiseAnd
+			reduce(62), // <=, reduce: BitwiseAnd
+			reduce(62), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S100
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(64), // ], reduce: Sum
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(64), // ||, reduce: Sum
+			reduce(64), // &&, reduce: Sum
+			nil,        // !
+			reduce(64), // and, reduce: Sum
+			shift(182), // mul
+			nil,        // intLit
+			reduce(64), // plus, reduce: Sum
+			reduce(64), // minus, reduce: Sum
+			reduce(64), // ==, reduce: Sum
+			reduce(64), // !=, reduce: Sum
+			reduce(64), // <, reduce: Sum
+			reduce(64), // >, reduce: Sum
+			reduce(64), // <=, reduce: Sum
+			reduce(64), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S101
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(66), // ], reduce: Product
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(66), // ||, reduce: Product
+			reduce(66), // &&, reduce: Product
+			nil,        // !
+			reduce(66), // and, reduce: Product
+			reduce(66), // mul, reduce: Product
+			nil,        // intLit
+			reduce(66), // plus, reduce: Product
+			reduce(66), // minus, reduce: Product
+			reduce(66), // ==, reduce: Product
+			reduce(66), // !=, reduce: Product
+			reduce(66), // <, reduce: Product
+			reduce(66), // >, reduce: Product
+			reduce(66), // <=, reduce: Product
+			reduce(66), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S102
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(67), // ], reduce: Term
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(67), // ||, reduce: Term
+			reduce(67), // &&, reduce: Term
+			nil,        // !
+			reduce(67), // and, reduce: Term
+			reduce(67), // mul, reduce: Term
+			nil,        // intLit
+			reduce(67), // plus, reduce: Term
+			reduce(67), // minus, reduce: Term
+			reduce(67), // ==, reduce: Term
+			reduce(67), // !=, reduce: Term
+			reduce(67), // <, reduce: Term
+			reduce(67), // >, reduce: Term
+			reduce(67), // <=, reduce: Term
+			reduce(67), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S103
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(183), // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(13), // ), reduce: Parameters
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S104
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(11), // $, reduce: Declaration
+			nil,        // empty
+			reduce(11), // proc, reduce: Declaration
+			reduce(11), // identifier, reduce: Declaration
+			reduce(11), // fn, reduce: Declaration
+			nil,        // (
+			nil,        // )
+			reduce(11), // const, reduce: Declaration
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(11), // @, reduce: Declaration
+			nil,        // [
+			nil,        // ]
+			reduce(11), // match, reduce: Declaration
+			reduce(11), // while, reduce: Declaration
+			reduce(11), // wait, reduce: Declaration
+			reduce(11), // for, reduce: Declaration
+			reduce(11), // break, reduce: Declaration
+			reduce(11), // continue, reduce: Declaration
+			reduce(11), // return, reduce: Declaration
+			reduce(11), // error, reduce: Declaration
+			reduce(11), // if, reduce: Declaration
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S105
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S106
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S107
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S108
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(76),  // identifier
+			nil,        // fn
+			shift(77),  // (
+			reduce(17), // ), reduce: Arguments
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(83),  // !
+			nil,        // and
+			nil,        // mul
+			shift(89),  // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S109
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S110
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			shift(191), // (
+			reduce(69), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			shift(192), // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(69), // ||, reduce: Term
+			reduce(69), // &&, reduce: Term
+			nil,        // !
+			reduce(69), // and, reduce: Term
+			reduce(69), // mul, reduce: Term
+			nil,        // intLit
+			reduce(69), // plus, reduce: Term
+			reduce(69), // minus, reduce: Term
+			reduce(69), // ==, reduce: Term
+			reduce(69), // !=, reduce: Term
+			reduce(69), // <, reduce: Term
+			reduce(69), // >, reduce: Term
+			reduce(69), // <=, reduce: Term
+			reduce(69), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S111
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S112
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(194), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(195), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S113
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(54), // ), reduce: Expression
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(54), // ||, reduce: Expression
+			shift(196), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S114
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(56), // ), reduce: Conjunction
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(56), // ||, reduce: Conjunction
+			reduce(56), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S115
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S116
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(58), // ), reduce: Negation
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(58), // ||, reduce: Negation
+			reduce(58), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			shift(128), // ==
+			shift(129), // !=
+			shift(130), // <
+			shift(131), // >
+			shift(132), // <=
+			shift(133), // >=
+		},
+	},
+	actionRow{ // S117
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(60), // ), reduce: Comparison
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(60), // ||, reduce: Comparison
+			reduce(60), // &&, reduce: Comparison
+			nil,        // !
+			shift(199), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(60), // ==, reduce: Comparison
+			reduce(60), // !=, reduce: Comparison
+			reduce(60), // <, reduce: Comparison
+			reduce(60), // >, reduce: Comparison
+			reduce(60), // <=, reduce: Comparison
+			reduce(60), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S118
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(62), // ), reduce: BitwiseAnd
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(62), // ||, reduce: BitwiseAnd
+			reduce(62), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(62), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(62), // ==, reduce: BitwiseAnd
+			reduce(62), // !=, reduce: BitwiseAnd
+			reduce(62), // <, reduce: BitwiseAnd
+			reduce(62), // >, reduce: BitwiseAnd
+			reduce(62), // <=, reduce: BitwiseAnd
+			reduce(62), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S119
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(64), // ), reduce: Sum
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(64), // ||, reduce: Sum
+			reduce(64), // &&, reduce: Sum
+			nil,        // !
+			reduce(64), // and, reduce: Sum
+			shift(201), // mul
+			nil,        // intLit
+			reduce(64), // plus, reduce: Sum
+			reduce(64), // minus, reduce: Sum
+			reduce(64), // ==, reduce: Sum
+			reduce(64), // !=, reduce: Sum
+			reduce(64), // <, reduce: Sum
+			reduce(64), // >, reduce: Sum
+			reduce(64), // <=, reduce: Sum
+			reduce(64), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S120
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(66), // ), reduce: Product
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(66), // ||, reduce: Product
+			reduce(66), // &&, reduce: Product
+			nil,        // !
+			reduce(66), // and, reduce: Product
+			reduce(66), // mul, reduce: Product
+			nil,        // intLit
+			reduce(66), // plus, reduce: Product
+			reduce(66), // minus, reduce: Product
+			reduce(66), // ==, reduce: Product
+			reduce(66), // !=, reduce: Product
+			reduce(66), // <, reduce: Product
+			reduce(66), // >, reduce: Product
+			reduce(66), // <=, reduce: Product
+			reduce(66), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S121
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(67), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(67), // ||, reduce: Term
+			reduce(67), // &&, reduce: Term
+			nil,        // !
+			reduce(67), // and, reduce: Term
+			reduce(67), // mul, reduce: Term
+			nil,        // intLit
+			reduce(67), // plus, reduce: Term
+			reduce(67), // minus, reduce: Term
+			reduce(67), // ==, reduce: Term
+			reduce(67), // !=, reduce: Term
+			reduce(67), // <, reduce: Term
+			reduce(67), // >, reduce: Term
+			reduce(67), // <=, reduce: Term
+			reduce(67), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S122
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(50), // identifier, reduce: MatchArms
+			nil,        // fn
+			reduce(50), // (, reduce: MatchArms
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(50), // rbrace, reduce: MatchArms
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			reduce(50), // _, reduce: MatchArms
+			nil,        // ||
+			nil,        // &&
+			reduce(50), // !, reduce: MatchArms
+			nil,        // and
+			nil,        // mul
+			reduce(50), // intLit, reduce: MatchArms
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S123
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S124
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(50), // identifier, reduce: MatchArms
+			nil,        // fn
+			reduce(50), // (, reduce: MatchArms
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(50), // rbrace, reduce: MatchArms
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			reduce(50), // _, reduce: MatchArms
+			nil,        // ||
+			nil,        // &&
+			reduce(50), // !, reduce: MatchArms
+			nil,        // and
+			nil,        // mul
+			reduce(50), // intLit, reduce: MatchArms
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S125
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S126
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(57), // lbrace, reduce: Negation
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(57), // ||, reduce: Negation
+			reduce(57), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S127
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S128
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(74), // identifier, reduce: CompareOperation
+			nil,        // fn
+			reduce(74), // (, reduce: CompareOperation
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			reduce(74), // intLit, reduce: CompareOperation
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S129
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(75), // identifier, reduce: CompareOperation
+			nil,        // fn
+			reduce(75), // (, reduce: CompareOperation
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			reduce(75), // intLit, reduce: CompareOperation
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S130
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(76), // identifier, reduce: CompareOperation
+			nil,        // fn
+			reduce(76), // (, reduce: CompareOperation
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			reduce(76), // intLit, reduce: CompareOperation
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S131
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(77), // identifier, reduce: CompareOperation
+			nil,        // fn
+			reduce(77), // (, reduce: CompareOperation
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			reduce(77), // intLit, reduce: CompareOperation
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S132
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(78), // identifier, reduce: CompareOperation
+			nil,        // fn
+			reduce(78), // (, reduce: CompareOperation
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			reduce(78), // intLit, reduce: CompareOperation
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S133
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(79), // identifier, reduce: CompareOperation
+			nil,        // fn
+			reduce(79), // (, reduce: CompareOperation
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			reduce(79), // intLit, reduce: CompareOperation
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S134
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S135
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S136
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(72), // identifier, reduce: AddOperation
+			nil,        // fn
+			reduce(72), // (, reduce: AddOperation
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			reduce(72), // intLit, reduce: AddOperation
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S137
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(73), // identifier, reduce: AddOperation
+			nil,        // fn
+			reduce(73), // (, reduce: AddOperation
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			reduce(73), // intLit, reduce: AddOperation
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S138
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S139
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(29), // $, reduce: Statement
+			nil,        // empty
+			reduce(29), // proc, reduce: Statement
+			reduce(29), // identifier, reduce: Statement
+			reduce(29), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(29), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(29), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(29), // match, reduce: Statement
+			reduce(29), // while, reduce: Statement
+			reduce(29), // wait, reduce: Statement
+			reduce(29), // for, reduce: Statement
+			reduce(29), // break, reduce: Statement
+			reduce(29), // continue, reduce: Statement
+			reduce(29), // return, reduce: Statement
+			reduce(29), // error, reduce: Statement
+			reduce(29), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S140
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(30), // $, reduce: Statement
+			nil,        // empty
+			reduce(30), // proc, reduce: Statement
+			reduce(30), // identifier, reduce: Statement
+			reduce(30), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(30), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(30), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(30), // match, reduce: Statement
+			reduce(30), // while, reduce: Statement
+			reduce(30), // wait, reduce: Statement
+			reduce(30), // for, reduce: Statement
+			reduce(30), // break, reduce: Statement
+			reduce(30), // continue, reduce: Statement
+			reduce(30), // return, reduce: Statement
+			reduce(30), // error, reduce: Statement
+			reduce(30), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S141
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(210), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(195), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S142
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(33), // $, reduce: Statement
+			nil,        // empty
+			reduce(33), // proc, reduce: Statement
+			reduce(33), // identifier, reduce: Statement
+			reduce(33), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(33), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(33), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(33), // match, reduce: Statement
+			reduce(33), // while, reduce: Statement
+			reduce(33), // wait, reduce: Statement
+			reduce(33), // for, reduce: Statement
+			reduce(33), // break, reduce: Statement
+			reduce(33), // continue, reduce: Statement
+			reduce(33), // return, reduce: Statement
+			reduce(33), // error, reduce: Statement
+			reduce(33), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S143
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S144
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(106), // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S145
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(76),  // identifier
+			nil,        // fn
+			shift(77),  // (
+			reduce(17), // ), reduce: Arguments
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(83),  // !
+			nil,        // and
+			nil,        // mul
+			shift(89),  // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S146
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S147
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(214), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(195), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S148
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(38), // $, reduce: Statement
+			nil,        // empty
+			reduce(38), // proc, reduce: Statement
+			reduce(38), // identifier, reduce: Statement
+			reduce(38), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(38), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(38), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(38), // match, reduce: Statement
+			reduce(38), // while, reduce: Statement
+			reduce(38), // wait, reduce: Statement
+			reduce(38), // for, reduce: Statement
+			reduce(38), // break, reduce: Statement
+			reduce(38), // continue, reduce: Statement
+			reduce(38), // return, reduce: Statement
+			reduce(38), // error, reduce: Statement
+			reduce(38), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S149
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S150
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S151
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(57), // terminator, reduce: Negation
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(57), // ||, reduce: Negation
+			reduce(57), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S152
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S153
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S154
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S155
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S156
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(48), // $, reduce: ElseBlock
+			nil,        // empty
+			reduce(48), // proc, reduce: ElseBlock
+			reduce(48), // identifier, reduce: ElseBlock
+			reduce(48), // fn, reduce: ElseBlock
+			nil,        // (
+			nil,        // )
+			reduce(48), // const, reduce: ElseBlock
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(48), // @, reduce: ElseBlock
+			nil,        // [
+			nil,        // ]
+			reduce(48), // match, reduce: ElseBlock
+			reduce(48), // while, reduce: ElseBlock
+			reduce(48), // wait, reduce: ElseBlock
+			reduce(48), // for, reduce: ElseBlock
+			reduce(48), // break, reduce: ElseBlock
+			reduce(48), // continue, reduce: ElseBlock
+			reduce(48), // return, reduce: ElseBlock
+			reduce(48), // error, reduce: ElseBlock
+			reduce(48), // if, reduce: ElseBlock
+			shift(222), // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S157
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(21), // identifier, reduce: Statements
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(21), // rbrace, reduce: Statements
+			reduce(21), // @, reduce: Statements
+			nil,        // [
+			nil,        // ]
+			reduce(21), // match, reduce: Statements
+			reduce(21), // while, reduce: Statements
+			reduce(21), // wait, reduce: Statements
+			reduce(21), // for, reduce: Statements
+			reduce(21), // break, reduce: Statements
+			reduce(21), // continue, reduce: Statements
+			reduce(21), // return, reduce: Statements
+			reduce(21), // error, reduce: Statements
+			reduce(21), // if, reduce: Statements
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S158
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(48), // $, reduce: ElseBlock
+			nil,        // empty
+			reduce(48), // proc, reduce: ElseBlock
+			reduce(48), // identifier, reduce: ElseBlock
+			reduce(48), // fn, reduce: ElseBlock
+			nil,        // (
+			nil,        // )
+			reduce(48), // const, reduce: ElseBlock
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(48), // @, reduce: ElseBlock
+			nil,        // [
+			nil,        // ]
+			reduce(48), // match, reduce: ElseBlock
+			reduce(48), // while, reduce: ElseBlock
+			reduce(48), // wait, reduce: ElseBlock
+			reduce(48), // for, reduce: ElseBlock
+			reduce(48), // break, reduce: ElseBlock
+			reduce(48), // continue, reduce: ElseBlock
+			reduce(48), // return, reduce: ElseBlock
+			reduce(48), // error, reduce: ElseBlock
+			reduce(48), // if, reduce: ElseBlock
+			shift(222), // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S159
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(226), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(228), // rbrace
+			shift(230), // @
+			nil,        // [
+			nil,        // ]
+			shift(232), // match
+			shift(233), // while
+			shift(234), // wait
+			shift(235), // for
+			shift(236), // break
+			shift(237), // continue
+			shift(238), // return
+			shift(239), // error
+			shift(240), // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S160
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(76),  // identifier
+			nil,        // fn
+			shift(77),  // (
+			reduce(17), // ), reduce: Arguments
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(83),  // !
+			nil,        // and
+			nil,        // mul
+			shift(89),  // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S161
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S162
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(243), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(195), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S163
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(76), // identifier
+			nil,       // fn
+			shift(77), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(83), // !
+			nil,       // and
+			nil,       // mul
+			shift(89), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S164
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(245), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S165
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(76), // identifier
+			nil,       // fn
+			shift(77), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(83), // !
+			nil,       // and
+			nil,       // mul
+			shift(89), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S166
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(76), // identifier
+			nil,       // fn
+			shift(77), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(83), // !
+			nil,       // and
+			nil,       // mul
+			shift(89), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S167
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(57), // ), reduce: Negation
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(57), // ,, reduce: Negation
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(57), // ||, reduce: Negation
+			reduce(57), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S168
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(76), // identifier
+			nil,       // fn
+			shift(77), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(89), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S169
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(76), // identifier
+			nil,       // fn
+			shift(77), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(89), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S170
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(76), // identifier
+			nil,       // fn
+			shift(77), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(89), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S171
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(76), // identifier
+			nil,       // fn
+			shift(77), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			shift(89), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S172
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(76),  // identifier
+			nil,        // fn
+			shift(77),  // (
+			reduce(17), // ), reduce: Arguments
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(83),  // !
+			nil,        // and
+			nil,        // mul
+			shift(89),  // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S173
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S174
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(254), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(195), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S175
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(255), // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S176
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S177
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S178
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(57), // ], reduce: Negation
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(57), // ||, reduce: Negation
+			reduce(57), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S179
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S180
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S181
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S182
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S183
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(15), // ), reduce: ParameterList
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(15), // ,, reduce: ParameterList
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S184
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(262), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S185
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(12), // ), reduce: Parameters
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			shift(263), // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S186
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(264), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S187
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(41), // terminator, reduce: SimpleStatement
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S188
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(265), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S189
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(266), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S190
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(267), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S191
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(76),  // identifier
+			nil,        // fn
+			shift(77),  // (
+			reduce(17), // ), reduce: Arguments
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(83),  // !
+			nil,        // and
+			nil,        // mul
+			shift(89),  // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S192
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S193
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(270), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(195), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S194
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(71), // lbrace, reduce: Term
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(71), // ||, reduce: Term
+			reduce(71), // &&, reduce: Term
+			nil,        // !
+			reduce(71), // and, reduce: Term
+			reduce(71), // mul, reduce: Term
+			nil,        // intLit
+			reduce(71), // plus, reduce: Term
+			reduce(71), // minus, reduce: Term
+			reduce(71), // ==, reduce: Term
+			reduce(71), // !=, reduce: Term
+			reduce(71), // <, reduce: Term
+			reduce(71), // >, reduce: Term
+			reduce(71), // <=, reduce: Term
+			reduce(71), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S195
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S196
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S197
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(57), // ), reduce: Negation
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(57), // ||, reduce: Negation
+			reduce(57), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S198
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S199
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S200
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S201
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S202
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(280), // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			shift(282), // _
+			nil,        // ||
+			nil,        // &&
+			shift(285), // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S203
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(53), // lbrace, reduce: Expression
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(53), // ||, reduce: Expression
+			shift(125), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S204
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(292), // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			shift(282), // _
+			nil,        // ||
+			nil,        // &&
+			shift(285), // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S205
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(55), // lbrace, reduce: Conjunction
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(55), // ||, reduce: Conjunction
+			reduce(55), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S206
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(59), // lbrace, reduce: Comparison
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(59), // ||, reduce: Comparison
+			reduce(59), // &&, reduce: Comparison
+			nil,        // !
+			shift(134), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(59), // ==, reduce: Comparison
+			reduce(59), // !=, reduce: Comparison
+			reduce(59), // <, reduce: Comparison
+			reduce(59), // >, reduce: Comparison
+			reduce(59), // <=, reduce: Comparison
+			reduce(59), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S207
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(61), // lbrace, reduce: BitwiseAnd
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(61), // ||, reduce: BitwiseAnd
+			reduce(61), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(61), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(61), // ==, reduce: BitwiseAnd
+			reduce(61), // !=, reduce: BitwiseAnd
+			reduce(61), // <, reduce: BitwiseAnd
+			reduce(61), // >, reduce: BitwiseAnd
+			reduce(61), // <=, reduce: BitwiseAnd
+			reduce(61), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S208
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(63), // lbrace, reduce: Sum
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(63), // ||, reduce: Sum
+			reduce(63), // &&, reduce: Sum
+			nil,        // !
+			reduce(63), // and, reduce: Sum
+			shift(138), // mul
+			nil,        // intLit
+			reduce(63), // plus, reduce: Sum
+			reduce(63), // minus, reduce: Sum
+			reduce(63), // ==, reduce: Sum
+			reduce(63), // !=, reduce: Sum
+			reduce(63), // <, reduce: Sum
+			reduce(63), // >, reduce: Sum
+			reduce(63), // <=, reduce: Sum
+			reduce(63), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S209
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(65), // lbrace, reduce: Product
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(65), // ||, reduce: Product
+			reduce(65), // &&, reduce: Product
+			nil,        // !
+			reduce(65), // and, reduce: Product
+			reduce(65), // mul, reduce: Product
+			nil,        // intLit
+			reduce(65), // plus, reduce: Product
+			reduce(65), // minus, reduce: Product
+			reduce(65), // ==, reduce: Product
+			reduce(65), // !=, reduce: Product
+			reduce(65), // <, reduce: Product
+			reduce(65), // >, reduce: Product
+			reduce(65), // <=, reduce: Product
+			reduce(65), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S210
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(293), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S211
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(294), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S212
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(295), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S213
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(296), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S214
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(71), // terminator, reduce: Term
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(71), // ||, reduce: Term
+			reduce(71), // &&, reduce: Term
+			nil,        // !
+			reduce(71), // and, reduce: Term
+			reduce(71), // mul, reduce: Term
+			nil,        // intLit
+			reduce(71), // plus, reduce: Term
+			reduce(71), // minus, reduce: Term
+			reduce(71), // ==, reduce: Term
+			reduce(71), // !=, reduce: Term
+			reduce(71), // <, reduce: Term
+			reduce(71), // >, reduce: Term
+			reduce(71), // <=, reduce: Term
+			reduce(71), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S215
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(53), // terminator, reduce: Expression
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(53), // ||, reduce: Expression
+			shift(150), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S216
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(55), // terminator, reduce: Conjunction
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(55), // ||, reduce: Conjunction
+			reduce(55), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S217
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(59), // terminator, reduce: Comparison
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(59), // ||, reduce: Comparison
+			reduce(59), // &&, reduce: Comparison
+			nil,        // !
+			shift(153), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(59), // ==, reduce: Comparison
+			reduce(59), // !=, reduce: Comparison
+			reduce(59), // <, reduce: Comparison
+			reduce(59), // >, reduce: Comparison
+			reduce(59), // <=, reduce: Comparison
+			reduce(59), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S218
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(61), // terminator, reduce: BitwiseAnd
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(61), // ||, reduce: BitwiseAnd
+			reduce(61), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(61), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(61), // ==, reduce: BitwiseAnd
+			reduce(61), // !=, reduce: BitwiseAnd
+			reduce(61), // <, reduce: BitwiseAnd
+			reduce(61), // >, reduce: BitwiseAnd
+			reduce(61), // <=, reduce: BitwiseAnd
+			reduce(61), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S219
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(63), // terminator, reduce: Sum
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(63), // ||, reduce: Sum
+			reduce(63), // &&, reduce: Sum
+			nil,        // !
+			reduce(63), // and, reduce: Sum
+			shift(155), // mul
+			nil,        // intLit
+			reduce(63), // plus, reduce: Sum
+			reduce(63), // minus, reduce: Sum
+			reduce(63), // ==, reduce: Sum
+			reduce(63), // !=, reduce: Sum
+			reduce(63), // <, reduce: Sum
+			reduce(63), // >, reduce: Sum
+			reduce(63), // <=, reduce: Sum
+			reduce(63), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S220
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(65), // terminator, reduce: Product
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(65), // ||, reduce: Product
+			reduce(65), // &&, reduce: Product
+			nil,        // !
+			reduce(65), // and, reduce: Product
+			reduce(65), // mul, reduce: Product
+			nil,        // intLit
+			reduce(65), // plus, reduce: Product
+			reduce(65), // minus, reduce: Product
+			reduce(65), // ==, reduce: Product
+			reduce(65), // !=, reduce: Product
+			reduce(65), // <, reduce: Product
+			reduce(65), // >, reduce: Product
+			reduce(65), // <=, reduce: Product
+			reduce(65), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S221
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(44), // $, reduce: IfStatement
+			nil,        // empty
+			reduce(44), // proc, reduce: IfStatement
+			reduce(44), // identifier, reduce: IfStatement
+			reduce(44), // fn, reduce: IfStatement
+			nil,        // (
+			nil,        // )
+			reduce(44), // const, reduce: IfStatement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(44), // @, reduce: IfStatement
+			nil,        // [
+			nil,        // ]
+			reduce(44), // match, reduce: IfStatement
+			reduce(44), // while, reduce: IfStatement
+			reduce(44), // wait, reduce: IfStatement
+			reduce(44), // for, reduce: IfStatement
+			reduce(44), // break, reduce: IfStatement
+			reduce(44), // continue, reduce: IfStatement
+			reduce(44), // return, reduce: IfStatement
+			reduce(44), // error, reduce: IfStatement
+			reduce(44), // if, reduce: IfStatement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S222
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			shift(74), // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			shift(21), // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S223
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(226), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(300), // rbrace
+			shift(230), // @
+			nil,        // [
+			nil,        // ]
+			shift(232), // match
+			shift(233), // while
+			shift(234), // wait
+			shift(235), // for
+			shift(236), // break
+			shift(237), // continue
+			shift(238), // return
+			shift(239), // error
+			shift(240), // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S224
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(45), // $, reduce: IfStatement
+			nil,        // empty
+			reduce(45), // proc, reduce: IfStatement
+			reduce(45), // identifier, reduce: IfStatement
+			reduce(45), // fn, reduce: IfStatement
+			nil,        // (
+			nil,        // )
+			reduce(45), // const, reduce: IfStatement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(45), // @, reduce: IfStatement
+			nil,        // [
+			nil,        // ]
+			reduce(45), // match, reduce: IfStatement
+			reduce(45), // while, reduce: IfStatement
+			reduce(45), // wait, reduce: IfStatement
+			reduce(45), // for, reduce: IfStatement
+			reduce(45), // break, reduce: IfStatement
+			reduce(45), // continue, reduce: IfStatement
+			reduce(45), // return, reduce: IfStatement
+			reduce(45), // error, reduce: IfStatement
+			reduce(45), // if, reduce: IfStatement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S225
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(20), // identifier, reduce: Statements
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(20), // rbrace, reduce: Statements
+			reduce(20), // @, reduce: Statements
+			nil,        // [
+			nil,        // ]
+			reduce(20), // match, reduce: Statements
+			reduce(20), // while, reduce: Statements
+			reduce(20), // wait, reduce: Statements
+			reduce(20), // for, reduce: Statements
+			reduce(20), // break, reduce: Statements
+			reduce(20), // continue, reduce: Statements
+			reduce(20), // return, reduce: Statements
+			reduce(20), // error, reduce: Statements
+			reduce(20), // if, reduce: Statements
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S226
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			shift(301), // (
+			nil,        // )
+			nil,        // const
+			shift(28),  // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			shift(29),  // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S227
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(302), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(303), // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S228
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(22), // $, reduce: StatementBlock
+			nil,        // empty
+			reduce(22), // proc, reduce: StatementBlock
+			reduce(22), // identifier, reduce: StatementBlock
+			reduce(22), // fn, reduce: StatementBlock
+			nil,        // (
+			nil,        // )
+			reduce(22), // const, reduce: StatementBlock
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(22), // @, reduce: StatementBlock
+			nil,        // [
+			nil,        // ]
+			reduce(22), // match, reduce: StatementBlock
+			reduce(22), // while, reduce: StatementBlock
+			reduce(22), // wait, reduce: StatementBlock
+			reduce(22), // for, reduce: StatementBlock
+			reduce(22), // break, reduce: StatementBlock
+			reduce(22), // continue, reduce: StatementBlock
+			reduce(22), // return, reduce: StatementBlock
+			reduce(22), // error, reduce: StatementBlock
+			reduce(22), // if, reduce: StatementBlock
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S229
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(304), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S230
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(305), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S231
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(26), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(26), // rbrace, reduce: Statement
+			reduce(26), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(26), // match, reduce: Statement
+			reduce(26), // while, reduce: Statement
+			reduce(26), // wait, reduce: Statement
+			reduce(26), // for, reduce: Statement
+			reduce(26), // break, reduce: Statement
+			reduce(26), // continue, reduce: Statement
+			reduce(26), // return, reduce: Statement
+			reduce(26), // error, reduce: Statement
+			reduce(26), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S232
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S233
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S234
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			shift(310), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S235
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(52), // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			shift(55), // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S236
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(313), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S237
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(314), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S238
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(58),  // identifier
+			nil,        // fn
+			shift(59),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(316), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(64),  // !
+			nil,        // and
+			nil,        // mul
+			shift(70),  // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S239
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(40), // terminator, reduce: BadStatement
+			nil,        // ,
+			nil,        // lbrace
+			reduce(40), // rbrace, reduce: BadStatement
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S240
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			shift(26), // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S241
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(319), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S242
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(320), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S243
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(71), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(71), // ,, reduce: Term
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(71), // ||, reduce: Term
+			reduce(71), // &&, reduce: Term
+			nil,        // !
+			reduce(71), // and, reduce: Term
+			reduce(71), // mul, reduce: Term
+			nil,        // intLit
+			reduce(71), // plus, reduce: Term
+			reduce(71), // minus, reduce: Term
+			reduce(71), // ==, reduce: Term
+			reduce(71), // !=, reduce: Term
+			reduce(71), // <, reduce: Term
+			reduce(71), // >, reduce: Term
+			reduce(71), // <=, reduce: Term
+			reduce(71), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S244
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(53), // ), reduce: Expression
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(53), // ,, reduce: Expression
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(53), // ||, reduce: Expression
+			shift(166), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S245
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(36), // $, reduce: Statement
+			nil,        // empty
+			reduce(36), // proc, reduce: Statement
+			reduce(36), // identifier, reduce: Statement
+			reduce(36), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(36), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(36), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(36), // match, reduce: Statement
+			reduce(36), // while, reduce: Statement
+			reduce(36), // wait, reduce: Statement
+			reduce(36), // for, reduce: Statement
+			reduce(36), // break, reduce: Statement
+			reduce(36), // continue, reduce: Statement
+			reduce(36), // return, reduce: Statement
+			reduce(36), // error, reduce: Statement
+			reduce(36), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S246
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(18), // ), reduce: ArgumentList
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(18), // ,, reduce: ArgumentList
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(163), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S247
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(55), // ), reduce: Conjunction
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(55), // ,, reduce: Conjunction
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(55), // ||, reduce: Conjunction
+			reduce(55), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S248
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(59), // ), reduce: Comparison
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(59), // ,, reduce: Comparison
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(59), // ||, reduce: Comparison
+			reduce(59), // &&, reduce: Comparison
+			nil,        // !
+			shift(169), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(59), // ==, reduce: Comparison
+			reduce(59), // !=, reduce: Comparison
+			reduce(59), // <, reduce: Comparison
+			reduce(59), // >, reduce: Comparison
+			reduce(59), // <=, reduce: Comparison
+			reduce(59), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S249
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(61), // ), reduce: BitwiseAnd
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(61), // ,, reduce: BitwiseAnd
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(61), // ||, reduce: BitwiseAnd
+			reduce(61), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(61), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(61), // ==, reduce: BitwiseAnd
+			reduce(61), // !=, reduce: BitwiseAnd
+			reduce(61), // <, reduce: BitwiseAnd
+			reduce(61), // >, reduce: BitwiseAnd
+			reduce(61), // <=, reduce: BitwiseAnd
+			reduce(61), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S250
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(63), // ), reduce: Sum
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(63), // ,, reduce: Sum
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(63), // ||, reduce: Sum
+			reduce(63), // &&, reduce: Sum
+			nil,        // !
+			reduce(63), // and, reduce: Sum
+			shift(171), // mul
+			nil,        // intLit
+			reduce(63), // plus, reduce: Sum
+			reduce(63), // minus, reduce: Sum
+			reduce(63), // ==, reduce: Sum
+			reduce(63), // !=, reduce: Sum
+			reduce(63), // <, reduce: Sum
+			reduce(63), // >, reduce: Sum
+			reduce(63), // <=, reduce: Sum
+			reduce(63), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S251
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(65), // ), reduce: Product
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(65), // ,, reduce: Product
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(65), // ||, reduce: Product
+			reduce(65), // &&, reduce: Product
+			nil,        // !
+			reduce(65), // and, reduce: Product
+			reduce(65), // mul, reduce: Product
+			nil,        // intLit
+			reduce(65), // plus, reduce: Product
+			reduce(65), // minus, reduce: Product
+			reduce(65), // ==, reduce: Product
+			reduce(65), // !=, reduce: Product
+			reduce(65), // <, reduce: Product
+			reduce(65), // >, reduce: Product
+			reduce(65), // <=, reduce: Product
+			reduce(65), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S252
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(321), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S253
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(322), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S254
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(71), // ], reduce: Term
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(71), // ||, reduce: Term
+			reduce(71), // &&, reduce: Term
+			nil,        // !
+			reduce(71), // and, reduce: Term
+			reduce(71), // mul, reduce: Term
+			nil,        // intLit
+			reduce(71), // plus, reduce: Term
+			reduce(71), // minus, reduce: Term
+			reduce(71), // ==, reduce: Term
+			reduce(71), // !=, reduce: Term
+			reduce(71), // <, reduce: Term
+			reduce(71), // >, reduce: Term
+			reduce(71), // <=, reduce: Term
+			reduce(71), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S255
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S256
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(53), // ], reduce: Expression
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(53), // ||, reduce: Expression
+			shift(177), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S257
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(55), // ], reduce: Conjunction
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(55), // ||, reduce: Conjunction
+			reduce(55), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S258
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(59), // ], reduce: Comparison
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(59), // ||, reduce: Comparison
+			reduce(59), // &&, reduce: Comparison
+			nil,        // !
+			shift(180), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(59), // ==, reduce: Comparison
+			reduce(59), // !=, reduce: Comparison
+			reduce(59), // <, reduce: Comparison
+			reduce(59), // >, reduce: Comparison
+			reduce(59), // <=, reduce: Comparison
+			reduce(59), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S259
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(61), // ], reduce: BitwiseAnd
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(61), // ||, reduce: BitwiseAnd
+			reduce(61), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(61), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(61), // ==, reduce: BitwiseAnd
+			reduce(61), // !=, reduce: BitwiseAnd
+			reduce(61), // <, reduce: BitwiseAnd
+			reduce(61), // >, reduce: BitwiseAnd
+			reduce(61), // <=, reduce: BitwiseAnd
+			reduce(61), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S260
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(63), // ], reduce: Sum
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(63), // ||, reduce: Sum
+			reduce(63), // &&, reduce: Sum
+			nil,        // !
+			reduce(63), // and, reduce: Sum
+			shift(182), // mul
+			nil,        // intLit
+			reduce(63), // plus, reduce: Sum
+			reduce(63), // minus, reduce: Sum
+			reduce(63), // ==, reduce: Sum
+			reduce(63), // !=, reduce: Sum
+			reduce(63), // <, reduce: Sum
+			reduce(63), // >, reduce: Sum
+			reduce(63), // <=, reduce: Sum
+			reduce(63), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S261
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(65), // ], reduce: Product
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(65), // ||, reduce: Product
+			reduce(65), // &&, reduce: Product
+			nil,        // !
+			reduce(65), // and, reduce: Product
+			reduce(65), // mul, reduce: Product
+			nil,        // intLit
+			reduce(65), // plus, reduce: Product
+			reduce(65), // minus, reduce: Product
+			reduce(65), // ==, reduce: Product
+			reduce(65), // !=, reduce: Product
+			reduce(65), // <, reduce: Product
+			reduce(65), // >, reduce: Product
+			reduce(65), // <=, reduce: Product
+			reduce(65), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S262
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			shift(74), // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S263
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(325), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S264
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(9), // $, reduce: Declaration
+			nil,       // empty
+			reduce(9), // proc, reduce: Declaration
+			reduce(9), // identifier, reduce: Declaration
+			reduce(9), // fn, reduce: Declaration
+			nil,       // (
+			nil,       // )
+			reduce(9), // const, reduce: Declaration
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			reduce(9), // @, reduce: Declaration
+			nil,       // [
+			nil,       // ]
+			reduce(9), // match, reduce: Declaration
+			reduce(9), // while, reduce: Declaration
+			reduce(9), // wait, reduce: Declaration
+			reduce(9), // for, reduce: Declaration
+			reduce(9), // break, reduce: Declaration
+			reduce(9), // continue, reduce: Declaration
+			reduce(9), // return, reduce: Declaration
+			reduce(9), // error, reduce: Declaration
+			reduce(9), // if, reduce: Declaration
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S265
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(326), // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S266
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(70), // lbrace, reduce: Term
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(70), // ||, reduce: Term
+			reduce(70), // &&, reduce: Term
+			nil,        // !
+			reduce(70), // and, reduce: Term
+			reduce(70), // mul, reduce: Term
+			nil,        // intLit
+			reduce(70), // plus, reduce: Term
+			reduce(70), // minus, reduce: Term
+			reduce(70), // ==, reduce: Term
+			reduce(70), // !=, reduce: Term
+			reduce(70), // <, reduce: Term
+			reduce(70), // >, reduce: Term
+			reduce(70), // <=, reduce: Term
+			reduce(70), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S267
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(68), // lbrace, reduce: Term
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(68), // ||, reduce: Term
+			reduce(68), // &&, reduce: Term
+			nil,        // !
+			reduce(68), // and, reduce: Term
+			reduce(68), // mul, reduce: Term
+			nil,        // intLit
+			reduce(68), // plus, reduce: Term
+			reduce(68), // minus, reduce: Term
+			reduce(68), // ==, reduce: Term
+			reduce(68), // !=, reduce: Term
+			reduce(68), // <, reduce: Term
+			reduce(68), // >, reduce: Term
+			reduce(68), // <=, reduce: Term
+			reduce(68), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S268
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(327), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S269
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(328), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S270
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(71), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(71), // ||, reduce: Term
+			reduce(71), // &&, reduce: Term
+			nil,        // !
+			reduce(71), // and, reduce: Term
+			reduce(71), // mul, reduce: Term
+			nil,        // intLit
+			reduce(71), // plus, reduce: Term
+			reduce(71), // minus, reduce: Term
+			reduce(71), // ==, reduce: Term
+			reduce(71), // !=, reduce: Term
+			reduce(71), // <, reduce: Term
+			reduce(71), // >, reduce: Term
+			reduce(71), // <=, reduce: Term
+			reduce(71), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S271
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(53), // ), reduce: Expression
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(53), // ||, reduce: Expression
+			shift(196), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S272
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(55), // ), reduce: Conjunction
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(55), // ||, reduce: Conjunction
+			reduce(55), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S273
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(59), // ), reduce: Comparison
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(59), // ||, reduce: Comparison
+			reduce(59), // &&, reduce: Comparison
+			nil,        // !
+			shift(199), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(59), // ==, reduce: Comparison
+			reduce(59), // !=, reduce: Comparison
+			reduce(59), // <, reduce: Comparison
+			reduce(59), // >, reduce: Comparison
+			reduce(59), // <=, reduce: Comparison
+			reduce(59), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S274
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(61), // ), reduce: BitwiseAnd
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(61), // ||, reduce: BitwiseAnd
+			reduce(61), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(61), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(61), // ==, reduce: BitwiseAnd
+			reduce(61), // !=, reduce: BitwiseAnd
+			reduce(61), // <, reduce: BitwiseAnd
+			reduce(61), // >, reduce: BitwiseAnd
+			reduce(61), // <=, reduce: BitwiseAnd
+			reduce(61), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S275
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(63), // ), reduce: Sum
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(63), // ||, reduce: Sum
+			reduce(63), // &&, reduce: Sum
+			nil,        // !
+			reduce(63), // and, reduce: Sum
+			shift(201), // mul
+			nil,        // intLit
+			reduce(63), // plus, reduce: Sum
+			reduce(63), // minus, reduce: Sum
+			reduce(63), // ==, reduce: Sum
+			reduce(63), // !=, reduce: Sum
+			reduce(63), // <, reduce: Sum
+			reduce(63), // >, reduce: Sum
+			reduce(63), // <=, reduce: Sum
+			reduce(63), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S276
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(65), // ), reduce: Product
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(65), // ||, reduce: Product
+			reduce(65), // &&, reduce: Product
+			nil,        // !
+			reduce(65), // and, reduce: Product
+			reduce(65), // mul, reduce: Product
+			nil,        // intLit
+			reduce(65), // plus, reduce: Product
+			reduce(65), // minus, reduce: Product
+			reduce(65), // ==, reduce: Product
+			reduce(65), // !=, reduce: Product
+			reduce(65), // <, reduce: Product
+			reduce(65), // >, reduce: Product
+			reduce(65), // <=, reduce: Product
+			reduce(65), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S277
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			shift(329), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			shift(330), // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(69), // =>, reduce: Term
+			nil,        // _
+			reduce(69), // ||, reduce: Term
+			reduce(69), // &&, reduce: Term
+			nil,        // !
+			reduce(69), // and, reduce: Term
+			reduce(69), // mul, reduce: Term
+			nil,        // intLit
+			reduce(69), // plus, reduce: Term
+			reduce(69), // minus, reduce: Term
+			reduce(69), // ==, reduce: Term
+			reduce(69), // !=, reduce: Term
+			reduce(69), // <, reduce: Term
+			reduce(69), // >, reduce: Term
+			reduce(69), // <=, reduce: Term
+			reduce(69), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S278
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S279
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			shift(332), // =>
+			nil,        // _
+			shift(333), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S280
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(27), // $, reduce: Statement
+			nil,        // empty
+			reduce(27), // proc, reduce: Statement
+			reduce(27), // identifier, reduce: Statement
+			reduce(27), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(27), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(27), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(27), // match, reduce: Statement
+			reduce(27), // while, reduce: Statement
+			reduce(27), // wait, reduce: Statement
+			reduce(27), // for, reduce: Statement
+			reduce(27), // break, reduce: Statement
+			reduce(27), // continue, reduce: Statement
+			reduce(27), // return, reduce: Statement
+			reduce(27), // error, reduce: Statement
+			reduce(27), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S281
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(49), // identifier, reduce: MatchArms
+			nil,        // fn
+			reduce(49), // (, reduce: MatchArms
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(49), // rbrace, reduce: MatchArms
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			reduce(49), // _, reduce: MatchArms
+			nil,        // ||
+			nil,        // &&
+			reduce(49), // !, reduce: MatchArms
+			nil,        // and
+			nil,        // mul
+			reduce(49), // intLit, reduce: MatchArms
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S282
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			shift(334), // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S283
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(54), // =>, reduce: Expression
+			nil,        // _
+			reduce(54), // ||, reduce: Expression
+			shift(335), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S284
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(56), // =>, reduce: Conjunction
+			nil,        // _
+			reduce(56), // ||, reduce: Conjunction
+			reduce(56), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S285
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(285), // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S286
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(58), // =>, reduce: Negation
+			nil,        // _
+			reduce(58), // ||, reduce: Negation
+			reduce(58), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			shift(128), // ==
+			shift(129), // !=
+			shift(130), // <
+			shift(131), // >
+			shift(132), // <=
+			shift(133), // >=
+		},
+	},
+	actionRow{ // S287
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(60), // =>, reduce: Comparison
+			nil,        // _
+			reduce(60), // ||, reduce: Comparison
+			reduce(60), // &&, reduce: Comparison
+			nil,        // !
+			shift(338), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(60), // ==, reduce: Comparison
+			reduce(60), // !=, reduce: Comparison
+			reduce(60), // <, reduce: Comparison
+			reduce(60), // >, reduce: Comparison
+			reduce(60), // <=, reduce: Comparison
+			reduce(60), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S288
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(62), // =>, reduce: BitwiseAnd
+			nil,        // _
+			reduce(62), // ||, reduce: BitwiseAnd
+			reduce(62), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(62), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(62), // ==, reduce: BitwiseAnd
+			reduce(62), // !=, reduce: BitwiseAnd
+			reduce(62), // <, reduce: BitwiseAnd
+			reduce(62), // >, reduce: BitwiseAnd
+			reduce(62), // <=, reduce: BitwiseAnd
+			reduce(62), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S289
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(64), // =>, reduce: Sum
+			nil,        // _
+			reduce(64), // ||, reduce: Sum
+			reduce(64), // &&, reduce: Sum
+			nil,        // !
+			reduce(64), // and, reduce: Sum
+			shift(340), // mul
+			nil,        // intLit
+			reduce(64), // plus, reduce: Sum
+			reduce(64), // minus, reduce: Sum
+			reduce(64), // ==, reduce: Sum
+			reduce(64), // !=, reduce: Sum
+			reduce(64), // <, reduce: Sum
+			reduce(64), // >, reduce: Sum
+			reduce(64), // <=, reduce: Sum
+			reduce(64), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S290
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(66), // =>, reduce: Product
+			nil,        // _
+			reduce(66), // ||, reduce: Product
+			reduce(66), // &&, reduce: Product
+			nil,        // !
+			reduce(66), // and, reduce: Product
+			reduce(66), // mul, reduce: Product
+			nil,        // intLit
+			reduce(66), // plus, reduce: Product
+			reduce(66), // minus, reduce: Product
+			reduce(66), // ==, reduce: Product
+			reduce(66), // !=, reduce: Product
+			reduce(66), // <, reduce: Product
+			reduce(66), // >, reduce: Product
+			reduce(66), // <=, reduce: Product
+			reduce(66), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S291
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(67), // =>, reduce: Term
+			nil,        // _
+			reduce(67), // ||, reduce: Term
+			reduce(67), // &&, reduce: Term
+			nil,        // !
+			reduce(67), // and, reduce: Term
+			reduce(67), // mul, reduce: Term
+			nil,        // intLit
+			reduce(67), // plus, reduce: Term
+			reduce(67), // minus, reduce: Term
+			reduce(67), // ==, reduce: Term
+			reduce(67), // !=, reduce: Term
+			reduce(67), // <, reduce: Term
+			reduce(67), // >, reduce: Term
+			reduce(67), // <=, reduce: Term
+			reduce(67), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S292
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(28), // $, reduce: Statement
+			nil,        // empty
+			reduce(28), // proc, reduce: Statement
+			reduce(28), // identifier, reduce: Statement
+			reduce(28), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(28), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(28), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(28), // match, reduce: Statement
+			reduce(28), // while, reduce: Statement
+			reduce(28), // wait, reduce: Statement
+			reduce(28), // for, reduce: Statement
+			reduce(28), // break, reduce: Statement
+			reduce(28), // continue, reduce: Statement
+			reduce(28), // return, reduce: Statement
+			reduce(28), // error, reduce: Statement
+			reduce(28), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S293
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(31), // $, reduce: Statement
+			nil,        // empty
+			reduce(31), // proc, reduce: Statement
+			reduce(31), // identifier, reduce: Statement
+			reduce(31), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(31), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(31), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(31), // match, reduce: Statement
+			reduce(31), // while, reduce: Statement
+			reduce(31), // wait, reduce: Statement
+			reduce(31), // for, reduce: Statement
+			reduce(31), // break, reduce: Statement
+			reduce(31), // continue, reduce: Statement
+			reduce(31), // return, reduce: Statement
+			reduce(31), // error, reduce: Statement
+			reduce(31), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S294
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(341), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			shift(343), // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S295
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(70), // terminator, reduce: Term
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(70), // ||, reduce: Term
+			reduce(70), // &&, reduce: Term
+			nil,        // !
+			reduce(70), // and, reduce: Term
+			reduce(70), // mul, reduce: Term
+			nil,        // intLit
+			reduce(70), // plus, reduce: Term
+			reduce(70), // minus, reduce: Term
+			reduce(70), // ==, reduce: Term
+			reduce(70), // !=, reduce: Term
+			reduce(70), // <, reduce: Term
+			reduce(70), // >, reduce: Term
+			reduce(70), // <=, reduce: Term
+			reduce(70), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S296
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(68), // terminator, reduce: Term
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(68), // ||, reduce: Term
+			reduce(68), // &&, reduce: Term
+			nil,        // !
+			reduce(68), // and, reduce: Term
+			reduce(68), // mul, reduce: Term
+			nil,        // intLit
+			reduce(68), // plus, reduce: Term
+			reduce(68), // minus, reduce: Term
+			reduce(68), // ==, reduce: Term
+			reduce(68), // !=, reduce: Term
+			reduce(68), // <, reduce: Term
+			reduce(68), // >, reduce: Term
+			reduce(68), // <=, reduce: Term
+			reduce(68), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S297
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(46), // $, reduce: ElseBlock
+			nil,        // empty
+			reduce(46), // proc, reduce: ElseBlock
+			reduce(46), // identifier, reduce: ElseBlock
+			reduce(46), // fn, reduce: ElseBlock
+			nil,        // (
+			nil,        // )
+			reduce(46), // const, reduce: ElseBlock
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(46), // @, reduce: ElseBlock
+			nil,        // [
+			nil,        // ]
+			reduce(46), // match, reduce: ElseBlock
+			reduce(46), // while, reduce: ElseBlock
+			reduce(46), // wait, reduce: ElseBlock
+			reduce(46), // for, reduce: ElseBlock
+			reduce(46), // break, reduce: ElseBlock
+			reduce(46), // continue, reduce: ElseBlock
+			reduce(46), // return, reduce: ElseBlock
+			reduce(46), // error, reduce: ElseBlock
+			reduce(46), // if, reduce: ElseBlock
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S298
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(47), // $, reduce: ElseBlock
+			nil,        // empty
+			reduce(47), // proc, reduce: ElseBlock
+			reduce(47), // identifier, reduce: ElseBlock
+			reduce(47), // fn, reduce: ElseBlock
+			nil,        // (
+			nil,        // )
+			reduce(47), // const, reduce: ElseBlock
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(47), // @, reduce: ElseBlock
+			nil,        // [
+			nil,        // ]
+			reduce(47), // match, reduce: ElseBlock
+			reduce(47), // while, reduce: ElseBlock
+			reduce(47), // wait, reduce: ElseBlock
+			reduce(47), // for, reduce: ElseBlock
+			reduce(47), // break, reduce: ElseBlock
+			reduce(47), // continue, reduce: ElseBlock
+			reduce(47), // return, reduce: ElseBlock
+			reduce(47), // error, reduce: ElseBlock
+			reduce(47), // if, reduce: ElseBlock
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S299
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(302), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(344), // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S300
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(22), // $, reduce: StatementBlock
+			nil,        // empty
+			reduce(22), // proc, reduce: StatementBlock
+			reduce(22), // identifier, reduce: StatementBlock
+			reduce(22), // fn, reduce: StatementBlock
+			nil,        // (
+			nil,        // )
+			reduce(22), // const, reduce: StatementBlock
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(22), // @, reduce: StatementBlock
+			nil,        // [
+			nil,        // ]
+			reduce(22), // match, reduce: StatementBlock
+			reduce(22), // while, reduce: StatementBlock
+			reduce(22), // wait, reduce: StatementBlock
+			reduce(22), // for, reduce: StatementBlock
+			reduce(22), // break, reduce: StatementBlock
+			reduce(22), // continue, reduce: StatementBlock
+			reduce(22), // return, reduce: StatementBlock
+			reduce(22), // error, reduce: StatementBlock
+			reduce(22), // if, reduce: StatementBlock
+			reduce(22), // else, reduce: StatementBlock
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S301
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(76),  // identifier
+			nil,        // fn
+			shift(77),  // (
+			reduce(17), // ), reduce: Arguments
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(83),  // !
+			nil,        // and
+			nil,        // mul
+			shift(89),  // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S302
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(39), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(39), // rbrace, reduce: Statement
+			reduce(39), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(39), // match, reduce: Statement
+			reduce(39), // while, reduce: Statement
+			reduce(39), // wait, reduce: Statement
+			reduce(39), // for, reduce: Statement
+			reduce(39), // break, reduce: Statement
+			reduce(39), // continue, reduce: Statement
+			reduce(39), // return, reduce: Statement
+			reduce(39), // error, reduce: Statement
+			reduce(39), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S303
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(23), // $, reduce: StatementBlock
+			nil,        // empty
+			reduce(23), // proc, reduce: StatementBlock
+			reduce(23), // identifier, reduce: StatementBlock
+			reduce(23), // fn, reduce: StatementBlock
+			nil,        // (
+			nil,        // )
+			reduce(23), // const, reduce: StatementBlock
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(23), // @, reduce: StatementBlock
+			nil,        // [
+			nil,        // ]
+			reduce(23), // match, reduce: StatementBlock
+			reduce(23), // while, reduce: StatementBlock
+			reduce(23), // wait, reduce: StatementBlock
+			reduce(23), // for, reduce: StatementBlock
+			reduce(23), // break, reduce: StatementBlock
+			reduce(23), // continue, reduce: StatementBlock
+			reduce(23), // return, reduce: StatementBlock
+			reduce(23), // error, reduce: StatementBlock
+			reduce(23), // if, reduce: StatementBlock
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S304
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(24), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(24), // rbrace, reduce: Statement
+			reduce(24), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(24), // match, reduce: Statement
+			reduce(24), // while, reduce: Statement
+			reduce(24), // wait, reduce: Statement
+			reduce(24), // for, reduce: Statement
+			reduce(24), // break, reduce: Statement
+			reduce(24), // continue, reduce: Statement
+			reduce(24), // return, reduce: Statement
+			reduce(24), // error, reduce: Statement
+			reduce(24), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S305
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(106), // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			shift(346), // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S306
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(347), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(123), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S307
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(348), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S308
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(350), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(123), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S309
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(350), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S310
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(110), // identifier
+			nil,        // fn
+			shift(111), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(115), // !
+			nil,        // and
+			nil,        // mul
+			shift(121), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S311
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(350), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S312
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(354), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S313
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(34), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(34), // rbrace, reduce: Statement
+			reduce(34), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(34), // match, reduce: Statement
+			reduce(34), // while, reduce: Statement
+			reduce(34), // wait, reduce: Statement
+			reduce(34), // for, reduce: Statement
+			reduce(34), // break, reduce: Statement
+			reduce(34), // continue, reduce: Statement
+			reduce(34), // return, reduce: Statement
+			reduce(34), // error, reduce: Statement
+			reduce(34), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S314
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(35), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(35), // rbrace, reduce: Statement
+			reduce(35), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(35), // match, reduce: Statement
+			reduce(35), // while, reduce: Statement
+			reduce(35), // wait, reduce: Statement
+			reduce(35), // for, reduce: Statement
+			reduce(35), // break, reduce: Statement
+			reduce(35), // continue, reduce: Statement
+			reduce(35), // return, reduce: Statement
+			reduce(35), // error, reduce: Statement
+			reduce(35), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S315
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(355), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S316
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(37), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(37), // rbrace, reduce: Statement
+			reduce(37), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(37), // match, reduce: Statement
+			reduce(37), // while, reduce: Statement
+			reduce(37), // wait, reduce: Statement
+			reduce(37), // for, reduce: Statement
+			reduce(37), // break, reduce: Statement
+			reduce(37), // continue, reduce: Statement
+			reduce(37), // return, reduce: Statement
+			reduce(37), // error, reduce: Statement
+			reduce(37), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S317
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(357), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(123), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S318
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(357), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S319
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(70), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(70), // ,, reduce: Term
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(70), // ||, reduce: Term
+			reduce(70), // &&, reduce: Term
+			nil,        // !
+			reduce(70), // and, reduce: Term
+			reduce(70), // mul, reduce: Term
+			nil,        // intLit
+			reduce(70), // plus, reduce: Term
+			reduce(70), // minus, reduce: Term
+			reduce(70), // ==, reduce: Term
+			reduce(70), // !=, reduce: Term
+			reduce(70), // <, reduce: Term
+			reduce(70), // >, reduce: Term
+			reduce(70), // <=, reduce: Term
+			reduce(70), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S320
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(68), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(68), // ,, reduce: Term
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(68), // ||, reduce: Term
+			reduce(68), // &&, reduce: Term
+			nil,        // !
+			reduce(68), // and, reduce: Term
+			reduce(68), // mul, reduce: Term
+			nil,        // intLit
+			reduce(68), // plus, reduce: Term
+			reduce(68), // minus, reduce: Term
+			reduce(68), // ==, reduce: Term
+			reduce(68), // !=, reduce: Term
+			reduce(68), // <, reduce: Term
+			reduce(68), // >, reduce: Term
+			reduce(68), // <=, reduce: Term
+			reduce(68), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S321
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(70), // ], reduce: Term
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(70), // ||, reduce: Term
+			reduce(70), // &&, reduce: Term
+			nil,        // !
+			reduce(70), // and, reduce: Term
+			reduce(70), // mul, reduce: Term
+			nil,        // intLit
+			reduce(70), // plus, reduce: Term
+			reduce(70), // minus, reduce: Term
+			reduce(70), // ==, reduce: Term
+			reduce(70), // !=, reduce: Term
+			reduce(70), // <, reduce: Term
+			reduce(70), // >, reduce: Term
+			reduce(70), // <=, reduce: Term
+			reduce(70), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S322
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			reduce(68), // ], reduce: Term
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(68), // ||, reduce: Term
+			reduce(68), // &&, reduce: Term
+			nil,        // !
+			reduce(68), // and, reduce: Term
+			reduce(68), // mul, reduce: Term
+			nil,        // intLit
+			reduce(68), // plus, reduce: Term
+			reduce(68), // minus, reduce: Term
+			reduce(68), // ==, reduce: Term
+			reduce(68), // !=, reduce: Term
+			reduce(68), // <, reduce: Term
+			reduce(68), // >, reduce: Term
+			reduce(68), // <=, reduce: Term
+			reduce(68), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S323
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			reduce(43), // terminator, reduce: SimpleStatement
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S324
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			reduce(8), // $, reduce: Declaration
+			nil,       // empty
+			reduce(8), // proc, reduce: Declaration
+			reduce(8), // identifier, reduce: Declaration
+			reduce(8), // fn, reduce: Declaration
+			nil,       // (
+			nil,       // )
+			reduce(8), // const, reduce: Declaration
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			reduce(8), // @, reduce: Declaration
+			nil,       // [
+			nil,       // ]
+			reduce(8), // match, reduce: Declaration
+			reduce(8), // while, reduce: Declaration
+			reduce(8), // wait, reduce: Declaration
+			reduce(8), // for, reduce: Declaration
+			reduce(8), // break, reduce: Declaration
+			reduce(8), // continue, reduce: Declaration
+			reduce(8), // return, reduce: Declaration
+			reduce(8), // error, reduce: Declaration
+			reduce(8), // if, reduce: Declaration
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S325
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(14), // ), reduce: ParameterList
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			reduce(14), // ,, reduce: ParameterList
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S326
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S327
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(70), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(70), // ||, reduce: Term
+			reduce(70), // &&, reduce: Term
+			nil,        // !
+			reduce(70), // and, reduce: Term
+			reduce(70), // mul, reduce: Term
+			nil,        // intLit
+			reduce(70), // plus, reduce: Term
+			reduce(70), // minus, reduce: Term
+			reduce(70), // ==, reduce: Term
+			reduce(70), // !=, reduce: Term
+			reduce(70), // <, reduce: Term
+			reduce(70), // >, reduce: Term
+			reduce(70), // <=, reduce: Term
+			reduce(70), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S328
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			reduce(68), // ), reduce: Term
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			reduce(68), // ||, reduce: Term
+			reduce(68), // &&, reduce: Term
+			nil,        // !
+			reduce(68), // and, reduce: Term
+			reduce(68), // mul, reduce: Term
+			nil,        // intLit
+			reduce(68), // plus, reduce: Term
+			reduce(68), // minus, reduce: Term
+			reduce(68), // ==, reduce: Term
+			reduce(68), // !=, reduce: Term
+			reduce(68), // <, reduce: Term
+			reduce(68), // >, reduce: Term
+			reduce(68), // <=, reduce: Term
+			reduce(68), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S329
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(76),  // identifier
+			nil,        // fn
+			shift(77),  // (
+			reduce(17), // ), reduce: Arguments
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(83),  // !
+			nil,        // and
+			nil,        // mul
+			shift(89),  // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S330
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S331
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(362), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(195), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S332
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(364), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S333
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(285), // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S334
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(364), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S335
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(285), // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S336
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(57), // =>, reduce: Negation
+			nil,        // _
+			reduce(57), // ||, reduce: Negation
+			reduce(57), // &&, reduce: Negation
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S337
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S338
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S339
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S340
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S341
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(372), // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			shift(373), // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S342
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			nil,       // identifier
+			nil,       // fn
+			nil,       // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			shift(74), // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			nil,       // !
+			nil,       // and
+			nil,       // mul
+			nil,       // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S343
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(375), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S344
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(23), // $, reduce: StatementBlock
+			nil,        // empty
+			reduce(23), // proc, reduce: StatementBlock
+			reduce(23), // identifier, reduce: StatementBlock
+			reduce(23), // fn, reduce: StatementBlock
+			nil,        // (
+			nil,        // )
+			reduce(23), // const, reduce: StatementBlock
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(23), // @, reduce: StatementBlock
+			nil,        // [
+			nil,        // ]
+			reduce(23), // match, reduce: StatementBlock
+			reduce(23), // while, reduce: StatementBlock
+			reduce(23), // wait, reduce: StatementBlock
+			reduce(23), // for, reduce: StatementBlock
+			reduce(23), // break, reduce: StatementBlock
+			reduce(23), // continue, reduce: StatementBlock
+			reduce(23), // return, reduce: StatementBlock
+			reduce(23), // error, reduce: StatementBlock
+			reduce(23), // if, reduce: StatementBlock
+			reduce(23), // else, reduce: StatementBlock
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S345
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(376), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S346
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S347
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(50), // identifier, reduce: MatchArms
+			nil,        // fn
+			reduce(50), // (, reduce: MatchArms
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(50), // rbrace, reduce: MatchArms
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			reduce(50), // _, reduce: MatchArms
+			nil,        // ||
+			nil,        // &&
+			reduce(50), // !, reduce: MatchArms
+			nil,        // and
+			nil,        // mul
+			reduce(50), // intLit, reduce: MatchArms
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S348
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(50), // identifier, reduce: MatchArms
+			nil,        // fn
+			reduce(50), // (, reduce: MatchArms
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(50), // rbrace, reduce: MatchArms
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			reduce(50), // _, reduce: MatchArms
+			nil,        // ||
+			nil,        // &&
+			reduce(50), // !, reduce: MatchArms
+			nil,        // and
+			nil,        // mul
+			reduce(50), // intLit, reduce: MatchArms
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S349
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(29), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(29), // rbrace, reduce: Statement
+			reduce(29), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(29), // match, reduce: Statement
+			reduce(29), // while, reduce: Statement
+			reduce(29), // wait, reduce: Statement
+			reduce(29), // for, reduce: Statement
+			reduce(29), // break, reduce: Statement
+			reduce(29), // continue, reduce: Statement
+			reduce(29), // return, reduce: Statement
+			reduce(29), // error, reduce: Statement
+			reduce(29), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S350
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(21), // identifier, reduce: Statements
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(21), // rbrace, reduce: Statements
+			reduce(21), // @, reduce: Statements
+			nil,        // [
+			nil,        // ]
+			reduce(21), // match, reduce: Statements
+			reduce(21), // while, reduce: Statements
+			reduce(21), // wait, reduce: Statements
+			reduce(21), // for, reduce: Statements
+			reduce(21), // break, reduce: Statements
+			reduce(21), // continue, reduce: Statements
+			reduce(21), // return, reduce: Statements
+			reduce(21), // error, reduce: Statements
+			reduce(21), // if, reduce: Statements
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S351
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(30), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(30), // rbrace, reduce: Statement
+			reduce(30), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(30), // match, reduce: Statement
+			reduce(30), // while, reduce: Statement
+			reduce(30), // wait, reduce: Statement
+			reduce(30), // for, reduce: Statement
+			reduce(30), // break, reduce: Statement
+			reduce(30), // continue, reduce: Statement
+			reduce(30), // return, reduce: Statement
+			reduce(30), // error, reduce: Statement
+			reduce(30), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S352
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(381), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(195), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S353
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(33), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(33), // rbrace, reduce: Statement
+			reduce(33), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(33), // match, reduce: Statement
+			reduce(33), // while, reduce: Statement
+			reduce(33), // wait, reduce: Statement
+			reduce(33), // for, reduce: Statement
+			reduce(33), // break, reduce: Statement
+			reduce(33), // continue, reduce: Statement
+			reduce(33), // return, reduce: Statement
+			reduce(33), // error, reduce: Statement
+			reduce(33), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S354
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
 			nil,       // wait
-			nil,       // (
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
+			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
+			nil,       // plus
+			nil,       // minus
+			nil,       // ==
+			nil,       // !=
+			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S355
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(38), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(38), // rbrace, reduce: Statement
+			reduce(38), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(38), // match, reduce: Statement
+			reduce(38), // while, reduce: Statement
+			reduce(38), // wait, reduce: Statement
+			reduce(38), // for, reduce: Statement
+			reduce(38), // break, reduce: Statement
+			reduce(38), // continue, reduce: Statement
+			reduce(38), // return, reduce: Statement
+			reduce(38), // error, reduce: Statement
+			reduce(38), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S356
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(48), // identifier, reduce: ElseBlock
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(48), // rbrace, reduce: ElseBlock
+			reduce(48), // @, reduce: ElseBlock
+			nil,        // [
+			nil,        // ]
+			reduce(48), // match, reduce: ElseBlock
+			reduce(48), // while, reduce: ElseBlock
+			reduce(48), // wait, reduce: ElseBlock
+			reduce(48), // for, reduce: ElseBlock
+			reduce(48), // break, reduce: ElseBlock
+			reduce(48), // continue, reduce: ElseBlock
+			reduce(48), // return, reduce: ElseBlock
+			reduce(48), // error, reduce: ElseBlock
+			reduce(48), // if, reduce: ElseBlock
+			shift(384), // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S357
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(21), // identifier, reduce: Statements
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(21), // rbrace, reduce: Statements
+			reduce(21), // @, reduce: Statements
+			nil,        // [
+			nil,        // ]
+			reduce(21), // match, reduce: Statements
+			reduce(21), // while, reduce: Statements
+			reduce(21), // wait, reduce: Statements
+			reduce(21), // for, reduce: Statements
+			reduce(21), // break, reduce: Statements
+			reduce(21), // continue, reduce: Statements
+			reduce(21), // return, reduce: Statements
+			reduce(21), // error, reduce: Statements
+			reduce(21), // if, reduce: Statements
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S358
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(48), // identifier, reduce: ElseBlock
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(48), // rbrace, reduce: ElseBlock
+			reduce(48), // @, reduce: ElseBlock
+			nil,        // [
+			nil,        // ]
+			reduce(48), // match, reduce: ElseBlock
+			reduce(48), // while, reduce: ElseBlock
+			reduce(48), // wait, reduce: ElseBlock
+			reduce(48), // for, reduce: ElseBlock
+			reduce(48), // break, reduce: ElseBlock
+			reduce(48), // continue, reduce: ElseBlock
+			reduce(48), // return, reduce: ElseBlock
+			reduce(48), // error, reduce: ElseBlock
+			reduce(48), // if, reduce: ElseBlock
+			shift(384), // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S359
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(387), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S360
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			shift(388), // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S361
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(389), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S362
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(71), // =>, reduce: Term
+			nil,        // _
+			reduce(71), // ||, reduce: Term
+			reduce(71), // &&, reduce: Term
+			nil,        // !
+			reduce(71), // and, reduce: Term
+			reduce(71), // mul, reduce: Term
+			nil,        // intLit
+			reduce(71), // plus, reduce: Term
+			reduce(71), // minus, reduce: Term
+			reduce(71), // ==, reduce: Term
+			reduce(71), // !=, reduce: Term
+			reduce(71), // <, reduce: Term
+			reduce(71), // >, reduce: Term
+			reduce(71), // <=, reduce: Term
+			reduce(71), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S363
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(51), // identifier, reduce: MatchArm
+			nil,        // fn
+			reduce(51), // (, reduce: MatchArm
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(51), // rbrace, reduce: MatchArm
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			reduce(51), // _, reduce: MatchArm
+			nil,        // ||
+			nil,        // &&
+			reduce(51), // !, reduce: MatchArm
+			nil,        // and
+			nil,        // mul
+			reduce(51), // intLit, reduce: MatchArm
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S364
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(21), // identifier, reduce: Statements
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(21), // rbrace, reduce: Statements
+			reduce(21), // @, reduce: Statements
+			nil,        // [
+			nil,        // ]
+			reduce(21), // match, reduce: Statements
+			reduce(21), // while, reduce: Statements
+			reduce(21), // wait, reduce: Statements
+			reduce(21), // for, reduce: Statements
+			reduce(21), // break, reduce: Statements
+			reduce(21), // continue, reduce: Statements
+			reduce(21), // return, reduce: Statements
+			reduce(21), // error, reduce: Statements
+			reduce(21), // if, reduce: Statements
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S365
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(53), // =>, reduce: Expression
+			nil,        // _
+			reduce(53), // ||, reduce: Expression
+			shift(335), // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S366
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(52), // identifier, reduce: MatchArm
+			nil,        // fn
+			reduce(52), // (, reduce: MatchArm
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(52), // rbrace, reduce: MatchArm
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			reduce(52), // _, reduce: MatchArm
+			nil,        // ||
+			nil,        // &&
+			reduce(52), // !, reduce: MatchArm
+			nil,        // and
+			nil,        // mul
+			reduce(52), // intLit, reduce: MatchArm
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S367
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(55), // =>, reduce: Conjunction
+			nil,        // _
+			reduce(55), // ||, reduce: Conjunction
+			reduce(55), // &&, reduce: Conjunction
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S368
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(59), // =>, reduce: Comparison
+			nil,        // _
+			reduce(59), // ||, reduce: Comparison
+			reduce(59), // &&, reduce: Comparison
+			nil,        // !
+			shift(338), // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			reduce(59), // ==, reduce: Comparison
+			reduce(59), // !=, reduce: Comparison
+			reduce(59), // <, reduce: Comparison
+			reduce(59), // >, reduce: Comparison
+			reduce(59), // <=, reduce: Comparison
+			reduce(59), // >=, reduce: Comparison
+		},
+	},
+	actionRow{ // S369
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(61), // =>, reduce: BitwiseAnd
+			nil,        // _
+			reduce(61), // ||, reduce: BitwiseAnd
+			reduce(61), // &&, reduce: BitwiseAnd
+			nil,        // !
+			reduce(61), // and, reduce: BitwiseAnd
+			nil,        // mul
+			nil,        // intLit
+			shift(136), // plus
+			shift(137), // minus
+			reduce(61), // ==, reduce: BitwiseAnd
+			reduce(61), // !=, reduce: BitwiseAnd
+			reduce(61), // <, reduce: BitwiseAnd
+			reduce(61), // >, reduce: BitwiseAnd
+			reduce(61), // <=, reduce: BitwiseAnd
+			reduce(61), // >=, reduce: BitwiseAnd
+		},
+	},
+	actionRow{ // S370
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(63), // =>, reduce: Sum
+			nil,        // _
+			reduce(63), // ||, reduce: Sum
+			reduce(63), // &&, reduce: Sum
+			nil,        // !
+			reduce(63), // and, reduce: Sum
+			shift(340), // mul
+			nil,        // intLit
+			reduce(63), // plus, reduce: Sum
+			reduce(63), // minus, reduce: Sum
+			reduce(63), // ==, reduce: Sum
+			reduce(63), // !=, reduce: Sum
+			reduce(63), // <, reduce: Sum
+			reduce(63), // >, reduce: Sum
+			reduce(63), // <=, reduce: Sum
+			reduce(63), // >=, reduce: Sum
+		},
+	},
+	actionRow{ // S371
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(65), // =>, reduce: Product
+			nil,        // _
+			reduce(65), // ||, reduce: Product
+			reduce(65), // &&, reduce: Product
+			nil,        // !
+			reduce(65), // and, reduce: Product
+			reduce(65), // mul, reduce: Product
+			nil,        // intLit
+			reduce(65), // plus, reduce: Product
+			reduce(65), // minus, reduce: Product
+			reduce(65), // ==, reduce: Product
+			reduce(65), // !=, reduce: Product
+			reduce(65), // <, reduce: Product
+			reduce(65), // >, reduce: Product
+			reduce(65), // <=, reduce: Product
+			reduce(65), // >=, reduce: Product
+		},
+	},
+	actionRow{ // S372
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
 			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
+			nil,       // [
+			nil,       // ]
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
+		},
+	},
+	actionRow{ // S373
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(91),  // identifier
+			nil,        // fn
+			shift(92),  // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			shift(96),  // !
+			nil,        // and
+			nil,        // mul
+			shift(102), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S374
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(32), // $, reduce: Statement
+			nil,        // empty
+			reduce(32), // proc, reduce: Statement
+			reduce(32), // identifier, reduce: Statement
+			reduce(32), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(32), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(32), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(32), // match, reduce: Statement
+			reduce(32), // while, reduce: Statement
+			reduce(32), // wait, reduce: Statement
+			reduce(32), // for, reduce: Statement
+			reduce(32), // break, reduce: Statement
+			reduce(32), // continue, reduce: Statement
+			reduce(32), // return, reduce: Statement
+			reduce(32), // error, reduce: Statement
+			reduce(32), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S375
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(393), // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S376
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(394), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S377
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(395), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S378
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(396), // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			shift(282), // _
+			nil,        // ||
+			nil,        // &&
+			shift(285), // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S379
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(277), // identifier
+			nil,        // fn
+			shift(278), // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(397), // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			shift(282), // _
+			nil,        // ||
+			nil,        // &&
+			shift(285), // !
+			nil,        // and
+			nil,        // mul
+			shift(291), // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S380
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(226), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(399), // rbrace
+			shift(230), // @
+			nil,        // [
+			nil,        // ]
+			shift(232), // match
+			shift(233), // while
+			shift(234), // wait
+			shift(235), // for
+			shift(236), // break
+			shift(237), // continue
+			shift(238), // return
+			shift(239), // error
+			shift(240), // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S381
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(400), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S382
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(401), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S383
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(44), // identifier, reduce: IfStatement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(44), // rbrace, reduce: IfStatement
+			reduce(44), // @, reduce: IfStatement
+			nil,        // [
+			nil,        // ]
+			reduce(44), // match, reduce: IfStatement
+			reduce(44), // while, reduce: IfStatement
+			reduce(44), // wait, reduce: IfStatement
+			reduce(44), // for, reduce: IfStatement
+			reduce(44), // break, reduce: IfStatement
+			reduce(44), // continue, reduce: IfStatement
+			reduce(44), // return, reduce: IfStatement
+			reduce(44), // error, reduce: IfStatement
+			reduce(44), // if, reduce: IfStatement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S384
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(350), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			shift(240), // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S385
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(226), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(405), // rbrace
+			shift(230), // @
+			nil,        // [
+			nil,        // ]
+			shift(232), // match
+			shift(233), // while
+			shift(234), // wait
+			shift(235), // for
+			shift(236), // break
+			shift(237), // continue
+			shift(238), // return
+			shift(239), // error
+			shift(240), // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S386
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(45), // identifier, reduce: IfStatement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(45), // rbrace, reduce: IfStatement
+			reduce(45), // @, reduce: IfStatement
+			nil,        // [
+			nil,        // ]
+			reduce(45), // match, reduce: IfStatement
+			reduce(45), // while, reduce: IfStatement
+			reduce(45), // wait, reduce: IfStatement
+			reduce(45), // for, reduce: IfStatement
+			reduce(45), // break, reduce: IfStatement
+			reduce(45), // continue, reduce: IfStatement
+			reduce(45), // return, reduce: IfStatement
+			reduce(45), // error, reduce: IfStatement
+			reduce(45), // if, reduce: IfStatement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S387
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(25), // $, reduce: Statement
+			nil,        // empty
+			reduce(25), // proc, reduce: Statement
+			reduce(25), // identifier, reduce: Statement
+			reduce(25), // fn, reduce: Statement
+			nil,        // (
+			nil,        // )
+			reduce(25), // const, reduce: Statement
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			reduce(25), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(25), // match, reduce: Statement
+			reduce(25), // while, reduce: Statement
+			reduce(25), // wait, reduce: Statement
+			reduce(25), // for, reduce: Statement
+			reduce(25), // break, reduce: Statement
+			reduce(25), // continue, reduce: Statement
+			reduce(25), // return, reduce: Statement
+			reduce(25), // error, reduce: Statement
+			reduce(25), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S388
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(70), // =>, reduce: Term
+			nil,        // _
+			reduce(70), // ||, reduce: Term
+			reduce(70), // &&, reduce: Term
+			nil,        // !
+			reduce(70), // and, reduce: Term
+			reduce(70), // mul, reduce: Term
+			nil,        // intLit
+			reduce(70), // plus, reduce: Term
+			reduce(70), // minus, reduce: Term
+			reduce(70), // ==, reduce: Term
+			reduce(70), // !=, reduce: Term
+			reduce(70), // <, reduce: Term
+			reduce(70), // >, reduce: Term
+			reduce(70), // <=, reduce: Term
+			reduce(70), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S389
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			reduce(68), // =>, reduce: Term
+			nil,        // _
+			reduce(68), // ||, reduce: Term
+			reduce(68), // &&, reduce: Term
+			nil,        // !
+			reduce(68), // and, reduce: Term
+			reduce(68), // mul, reduce: Term
+			nil,        // intLit
+			reduce(68), // plus, reduce: Term
+			reduce(68), // minus, reduce: Term
+			reduce(68), // ==, reduce: Term
+			reduce(68), // !=, reduce: Term
+			reduce(68), // <, reduce: Term
+			reduce(68), // >, reduce: Term
+			reduce(68), // <=, reduce: Term
+			reduce(68), // >=, reduce: Term
+		},
+	},
+	actionRow{ // S390
+		canRecover: true,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			shift(226), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(407), // rbrace
+			shift(230), // @
+			nil,        // [
+			nil,        // ]
+			shift(232), // match
+			shift(233), // while
+			shift(234), // wait
+			shift(235), // for
+			shift(236), // break
+			shift(237), // continue
+			shift(238), // return
+			shift(239), // error
+			shift(240), // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S391
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(42), // lbrace, reduce: SimpleStatement
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(123), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S392
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			shift(408), // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(176), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S88
+	actionRow{ // S393
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(98), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
-	actionRow{ // S89
+	actionRow{ // S394
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			shift(99), // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			shift(32), // plus
-			shift(33), // minus
-			shift(34), // mul
-			shift(35), // and
-			shift(36), // ==
-			shift(37), // !=
-			shift(38), // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(36), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(36), // rbrace, reduce: Statement
+			reduce(36), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(36), // match, reduce: Statement
+			reduce(36), // while, reduce: Statement
+			reduce(36), // wait, reduce: Statement
+			reduce(36), // for, reduce: Statement
+			reduce(36), // break, reduce: Statement
+			reduce(36), // continue, reduce: Statement
+			reduce(36), // return, reduce: Statement
+			reduce(36), // error, reduce: Statement
+			reduce(36), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S90
+	actionRow{ // S395
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(410), // assign
+			nil,        // terminator
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			nil,        // terminator
 			nil,        // [
-			nil,        // intLit
-			shift(100), // ]
-			nil,        // if
+			nil,        // ]
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			shift(32),  // plus
-			shift(33),  // minus
-			shift(34),  // mul
-			shift(35),  // and
-			shift(36),  // ==
-			shift(37),  // !=
-			shift(38),  // <
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S91
+	actionRow{ // S396
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			reduce(13), // rbrace, reduce: ElseBlock
-			reduce(13), // @, reduce: ElseBlock
-			reduce(13), // identifier, reduce: ElseBlock
+			nil,        // proc
+			reduce(27), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(27), // rbrace, reduce: Statement
+			reduce(27), // @, reduce: Statement
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			reduce(13), // if, reduce: ElseBlock
-			reduce(13), // while, reduce: ElseBlock
-			reduce(13), // wait, reduce: ElseBlock
-			nil,        // (
-			nil,        // )
-			shift(102), // else
+			reduce(27), // match, reduce: Statement
+			reduce(27), // while, reduce: Statement
+			reduce(27), // wait, reduce: Statement
+			reduce(27), // for, reduce: Statement
+			reduce(27), // break, reduce: Statement
+			reduce(27), // continue, reduce: Statement
+			reduce(27), // return, reduce: Statement
+			reduce(27), // error, reduce: Statement
+			reduce(27), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S92
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(3), // rbrace, reduce: Statements
-			reduce(3), // @, reduce: Statements
-			reduce(3), // identifier, reduce: Statements
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(3), // if, reduce: Statements
-			reduce(3), // while, reduce: Statements
-			reduce(3), // wait, reduce: Statements
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S93
+	actionRow{ // S397
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(8), // rbrace, reduce: Statement
-			reduce(8), // @, reduce: Statement
-			reduce(8), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(8), // if, reduce: Statement
-			reduce(8), // while, reduce: Statement
-			reduce(8), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(28), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(28), // rbrace, reduce: Statement
+			reduce(28), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(28), // match, reduce: Statement
+			reduce(28), // while, reduce: Statement
+			reduce(28), // wait, reduce: Statement
+			reduce(28), // for, reduce: Statement
+			reduce(28), // break, reduce: Statement
+			reduce(28), // continue, reduce: Statement
+			reduce(28), // return, reduce: Statement
+			reduce(28), // error, reduce: Statement
+			reduce(28), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S94
+	actionRow{ // S398
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(3), // rbrace, reduce: Statements
-			reduce(3), // @, reduce: Statements
-			reduce(3), // identifier, reduce: Statements
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(3), // if, reduce: Statements
-			reduce(3), // while, reduce: Statements
-			reduce(3), // wait, reduce: Statements
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(302), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(411), // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S95
+	actionRow{ // S399
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
+			nil,        // proc
+			reduce(22), // identifier, reduce: StatementBlock
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(22), // rbrace, reduce: StatementBlock
+			reduce(22), // @, reduce: StatementBlock
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			shift(105), // )
+			reduce(22), // match, reduce: StatementBlock
+			reduce(22), // while, reduce: StatementBlock
+			reduce(22), // wait, reduce: StatementBlock
+			reduce(22), // for, reduce: StatementBlock
+			reduce(22), // break, reduce: StatementBlock
+			reduce(22), // continue, reduce: StatementBlock
+			reduce(22), // return, reduce: StatementBlock
+			reduce(22), // error, reduce: StatementBlock
+			reduce(22), // if, reduce: StatementBlock
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S96
+	actionRow{ // S400
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(6), // $, reduce: Statement
-			nil,       // empty
-			nil,       // lbrace
-			nil,       // rbrace
-			reduce(6), // @, reduce: Statement
-			reduce(6), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(6), // if, reduce: Statement
-			reduce(6), // while, reduce: Statement
-			reduce(6), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(31), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(31), // rbrace, reduce: Statement
+			reduce(31), // @, reduce: Statement
+			nil,        // [
+			nil,        // ]
+			reduce(31), // match, reduce: Statement
+			reduce(31), // while, reduce: Statement
+			reduce(31), // wait, reduce: Statement
+			reduce(31), // for, reduce: Statement
+			reduce(31), // break, reduce: Statement
+			reduce(31), // continue, reduce: Statement
+			reduce(31), // return, reduce: Statement
+			reduce(31), // error, reduce: Statement
+			reduce(31), // if, reduce: Statement
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S97
+	actionRow{ // S401
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			shift(341), // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			shift(106), // terminator
+			shift(343), // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
-			shift(32),  // plus
-			shift(33),  // minus
-			shift(34),  // mul
-			shift(35),  // and
-			shift(36),  // ==
-			shift(37),  // !=
-			shift(38),  // <
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S98
+	actionRow{ // S402
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
+			nil,        // proc
+			reduce(46), // identifier, reduce: ElseBlock
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(46), // rbrace, reduce: ElseBlock
+			reduce(46), // @, reduce: ElseBlock
 			nil,        // [
-			nil,        // intLit
-			shift(107), // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // ]
+			reduce(46), // match, reduce: ElseBlock
+			reduce(46), // while, reduce: ElseBlock
+			reduce(46), // wait, reduce: ElseBlock
+			reduce(46), // for, reduce: ElseBlock
+			reduce(46), // break, reduce: ElseBlock
+			reduce(46), // continue, reduce: ElseBlock
+			reduce(46), // return, reduce: ElseBlock
+			reduce(46), // error, reduce: ElseBlock
+			reduce(46), // if, reduce: ElseBlock
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S99
+	actionRow{ // S403
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(9), // rbrace, reduce: Statement
-			reduce(9), // @, reduce: Statement
-			reduce(9), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(9), // if, reduce: Statement
-			reduce(9), // while, reduce: Statement
-			reduce(9), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(47), // identifier, reduce: ElseBlock
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(47), // rbrace, reduce: ElseBlock
+			reduce(47), // @, reduce: ElseBlock
+			nil,        // [
+			nil,        // ]
+			reduce(47), // match, reduce: ElseBlock
+			reduce(47), // while, reduce: ElseBlock
+			reduce(47), // wait, reduce: ElseBlock
+			reduce(47), // for, reduce: ElseBlock
+			reduce(47), // break, reduce: ElseBlock
+			reduce(47), // continue, reduce: ElseBlock
+			reduce(47), // return, reduce: ElseBlock
+			reduce(47), // error, reduce: ElseBlock
+			reduce(47), // if, reduce: ElseBlock
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S100
+	actionRow{ // S404
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			shift(302), // terminator
+			nil,        // ,
 			nil,        // lbrace
-			nil,        // rbrace
+			shift(413), // rbrace
 			nil,        // @
-			nil,        // identifier
-			shift(108), // assign
-			nil,        // terminator
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S101
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(7), // rbrace, reduce: Statement
-			reduce(7), // @, reduce: Statement
-			reduce(7), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(7), // if, reduce: Statement
-			reduce(7), // while, reduce: Statement
-			reduce(7), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S102
+	actionRow{ // S405
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(94), // lbrace
-			nil,       // rbrace
-			nil,       // @
-			nil,       // identifier
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			nil,       // if
-			nil,       // while
-			nil,       // wait
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(22), // identifier, reduce: StatementBlock
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(22), // rbrace, reduce: StatementBlock
+			reduce(22), // @, reduce: StatementBlock
+			nil,        // [
+			nil,        // ]
+			reduce(22), // match, reduce: StatementBlock
+			reduce(22), // while, reduce: StatementBlock
+			reduce(22), // wait, reduce: StatementBlock
+			reduce(22), // for, reduce: StatementBlock
+			reduce(22), // break, reduce: StatementBlock
+			reduce(22), // continue, reduce: StatementBlock
+			reduce(22), // return, reduce: StatementBlock
+			reduce(22), // error, reduce: StatementBlock
+			reduce(22), // if, reduce: StatementBlock
+			reduce(22), // else, reduce: StatementBlock
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S103
+	actionRow{ // S406
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			shift(110), // rbrace
-			shift(68),  // @
-			shift(69),  // identifier
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
-			nil,        // terminator
+			shift(302), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			shift(414), // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			shift(70),  // if
-			shift(71),  // while
-			shift(72),  // wait
-			nil,        // (
-			nil,        // )
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S104
+	actionRow{ // S407
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			shift(111), // rbrace
-			shift(68),  // @
-			shift(69),  // identifier
+			nil,        // proc
+			reduce(22), // identifier, reduce: StatementBlock
+			nil,        // fn
+			reduce(22), // (, reduce: StatementBlock
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(22), // rbrace, reduce: StatementBlock
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			shift(70),  // if
-			shift(71),  // while
-			shift(72),  // wait
-			nil,        // (
-			nil,        // )
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			reduce(22), // _, reduce: StatementBlock
+			nil,        // ||
+			nil,        // &&
+			reduce(22), // !, reduce: StatementBlock
+			nil,        // and
+			nil,        // mul
+			reduce(22), // intLit, reduce: StatementBlock
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S105
+	actionRow{ // S408
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			shift(415), // assign
+			nil,        // terminator
+			nil,        // ,
 			nil,        // lbrace
 			nil,        // rbrace
 			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			shift(112), // terminator
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S106
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(5), // rbrace, reduce: Statement
-			reduce(5), // @, reduce: Statement
-			reduce(5), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(5), // if, reduce: Statement
-			reduce(5), // while, reduce: Statement
-			reduce(5), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
-		},
-	},
-	actionRow{ // S107
+	actionRow{ // S409
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
+			nil,        // proc
 			nil,        // identifier
-			shift(113), // assign
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			reduce(41), // lbrace, reduce: SimpleStatement
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(123), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S108
+	actionRow{ // S410
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
+			nil,       // proc
+			shift(58), // identifier
+			nil,       // fn
+			shift(59), // (
+			nil,       // )
+			nil,       // const
+			nil,       // assign
+			nil,       // terminator
+			nil,       // ,
 			nil,       // lbrace
 			nil,       // rbrace
 			nil,       // @
-			shift(20), // identifier
-			nil,       // assign
-			nil,       // terminator
 			nil,       // [
-			shift(22), // intLit
 			nil,       // ]
-			nil,       // if
+			nil,       // match
 			nil,       // while
 			nil,       // wait
-			nil,       // (
-			nil,       // )
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(64), // !
+			nil,       // and
+			nil,       // mul
+			shift(70), // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
-	actionRow{ // S109
+	actionRow{ // S411
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			reduce(12), // rbrace, reduce: ElseBlock
-			reduce(12), // @, reduce: ElseBlock
-			reduce(12), // identifier, reduce: ElseBlock
+			nil,        // proc
+			reduce(23), // identifier, reduce: StatementBlock
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(23), // rbrace, reduce: StatementBlock
+			reduce(23), // @, reduce: StatementBlock
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			reduce(12), // if, reduce: ElseBlock
-			reduce(12), // while, reduce: ElseBlock
-			reduce(12), // wait, reduce: ElseBlock
+			reduce(23), // match, reduce: StatementBlock
+			reduce(23), // while, reduce: StatementBlock
+			reduce(23), // wait, reduce: StatementBlock
+			reduce(23), // for, reduce: StatementBlock
+			reduce(23), // break, reduce: StatementBlock
+			reduce(23), // continue, reduce: StatementBlock
+			reduce(23), // return, reduce: StatementBlock
+			reduce(23), // error, reduce: StatementBlock
+			reduce(23), // if, reduce: StatementBlock
+			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S412
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
 			nil,        // (
 			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			shift(350), // lbrace
+			nil,        // rbrace
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
+		},
+	},
+	actionRow{ // S413
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(23), // identifier, reduce: StatementBlock
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(23), // rbrace, reduce: StatementBlock
+			reduce(23), // @, reduce: StatementBlock
+			nil,        // [
+			nil,        // ]
+			reduce(23), // match, reduce: StatementBlock
+			reduce(23), // while, reduce: StatementBlock
+			reduce(23), // wait, reduce: StatementBlock
+			reduce(23), // for, reduce: StatementBlock
+			reduce(23), // break, reduce: StatementBlock
+			reduce(23), // continue, reduce: StatementBlock
+			reduce(23), // return, reduce: StatementBlock
+			reduce(23), // error, reduce: StatementBlock
+			reduce(23), // if, reduce: StatementBlock
+			reduce(23), // else, reduce: StatementBlock
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
 			nil,        // and
+			nil,        // mul
+			nil,        // intLit
+			nil,        // plus
+			nil,        // minus
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S110
+	actionRow{ // S414
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(4), // rbrace, reduce: StatementBlock
-			reduce(4), // @, reduce: StatementBlock
-			reduce(4), // identifier, reduce: StatementBlock
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(4), // if, reduce: StatementBlock
-			reduce(4), // while, reduce: StatementBlock
-			reduce(4), // wait, reduce: StatementBlock
-			nil,       // (
-			nil,       // )
-			reduce(4), // else, reduce: StatementBlock
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // INVALID
+			nil,        // $
+			nil,        // empty
+			nil,        // proc
+			reduce(23), // identifier, reduce: StatementBlock
+			nil,        // fn
+			reduce(23), // (, reduce: StatementBlock
+			nil,        // )
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(23), // rbrace, reduce: StatementBlock
+			nil,        // @
+			nil,        // [
+			nil,        // ]
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
+			nil,        // else
+			nil,        // =>
+			reduce(23), // _, reduce: StatementBlock
+			nil,        // ||
+			nil,        // &&
+			reduce(23), // !, reduce: StatementBlock
+			nil,        // and
+			nil,        // mul
+			reduce(23), // intLit, reduce: StatementBlock
+			nil,        // plus
+			nil,        // minus
+			nil,        // ==
+			nil,        // !=
+			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S111
+	actionRow{ // S415
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // empty
-			nil,       // lbrace
-			reduce(4), // rbrace, reduce: StatementBlock
-			reduce(4), // @, reduce: StatementBlock
-			reduce(4), // identifier, reduce: StatementBlock
+			nil,       // proc
+			shift(36), // identifier
+			nil,       // fn
+			shift(37), // (
+			nil,       // )
+			nil,       // const
 			nil,       // assign
 			nil,       // terminator
+			nil,       // ,
+			nil,       // lbrace
+			nil,       // rbrace
+			nil,       // @
 			nil,       // [
-			nil,       // intLit
 			nil,       // ]
-			reduce(4), // if, reduce: StatementBlock
-			reduce(4), // while, reduce: StatementBlock
-			reduce(4), // wait, reduce: StatementBlock
-			nil,       // (
-			nil,       // )
+			nil,       // match
+			nil,       // while
+			nil,       // wait
+			nil,       // for
+			nil,       // break
+			nil,       // continue
+			nil,       // return
+			nil,       // error
+			nil,       // if
 			nil,       // else
+			nil,       // =>
+			nil,       // _
+			nil,       // ||
+			nil,       // &&
+			shift(42), // !
+			nil,       // and
+			nil,       // mul
+			shift(48), // intLit
 			nil,       // plus
 			nil,       // minus
-			nil,       // mul
-			nil,       // and
 			nil,       // ==
 			nil,       // !=
 			nil,       // <
+			nil,       // >
+			nil,       // <=
+			nil,       // >=
 		},
 	},
-	actionRow{ // S112
+	actionRow{ // S416
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			reduce(10), // rbrace, reduce: Statement
-			reduce(10), // @, reduce: Statement
-			reduce(10), // identifier, reduce: Statement
+			nil,        // proc
+			nil,        // identifier
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
-			nil,        // terminator
+			shift(419), // terminator
+			nil,        // ,
+			nil,        // lbrace
+			nil,        // rbrace
+			nil,        // @
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			reduce(10), // if, reduce: Statement
-			reduce(10), // while, reduce: Statement
-			reduce(10), // wait, reduce: Statement
-			nil,        // (
-			nil,        // )
+			nil,        // match
+			nil,        // while
+			nil,        // wait
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(149), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S113
+	actionRow{ // S417
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
-			nil,        // identifier
+			nil,        // proc
+			reduce(32), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(32), // rbrace, reduce: Statement
+			reduce(32), // @, reduce: Statement
 			nil,        // [
-			shift(115), // intLit
 			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
-			nil,        // (
-			nil,        // )
+			reduce(32), // match, reduce: Statement
+			reduce(32), // while, reduce: Statement
+			reduce(32), // wait, reduce: Statement
+			reduce(32), // for, reduce: Statement
+			reduce(32), // break, reduce: Statement
+			reduce(32), // continue, reduce: Statement
+			reduce(32), // return, reduce: Statement
+			reduce(32), // error, reduce: Statement
+			reduce(32), // if, reduce: Statement
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S114
+	actionRow{ // S418
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			nil,        // rbrace
-			nil,        // @
+			nil,        // proc
 			nil,        // identifier
-			nil,        // assign
-			shift(116), // terminator
-			nil,        // [
-			nil,        // intLit
-			nil,        // ]
-			nil,        // if
-			nil,        // while
-			nil,        // wait
+			nil,        // fn
 			nil,        // (
 			nil,        // )
-			nil,        // else
-			shift(32),  // plus
-			shift(33),  // minus
-			shift(34),  // mul
-			shift(35),  // and
-			shift(36),  // ==
-			shift(37),  // !=
-			shift(38),  // <
-		},
-	},
-	actionRow{ // S115
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // lbrace
+			nil,        // const
+			nil,        // assign
+			nil,        // terminator
+			nil,        // ,
+			reduce(43), // lbrace, reduce: SimpleStatement
 			nil,        // rbrace
 			nil,        // @
-			nil,        // identifier
-			nil,        // assign
-			shift(117), // terminator
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			nil,        // if
+			nil,        // match
 			nil,        // while
 			nil,        // wait
-			nil,        // (
-			nil,        // )
+			nil,        // for
+			nil,        // break
+			nil,        // continue
+			nil,        // return
+			nil,        // error
+			nil,        // if
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			shift(123), // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
-	actionRow{ // S116
+	actionRow{ // S419
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // empty
-			nil,        // lbrace
-			reduce(11), // rbrace, reduce: Statement
-			reduce(11), // @, reduce: Statement
-			reduce(11), // identifier, reduce: Statement
+			nil,        // proc
+			reduce(25), // identifier, reduce: Statement
+			nil,        // fn
+			nil,        // (
+			nil,        // )
+			nil,        // const
 			nil,        // assign
 			nil,        // terminator
+			nil,        // ,
+			nil,        // lbrace
+			reduce(25), // rbrace, reduce: Statement
+			reduce(25), // @, reduce: Statement
 			nil,        // [
-			nil,        // intLit
 			nil,        // ]
-			reduce(11), // if, reduce: Statement
-			reduce(11), // while, reduce: Statement
-			reduce(11), // wait, reduce: Statement
-			nil,        // (
-			nil,        // )
+			reduce(25), // match, reduce: Statement
+			reduce(25), // while, reduce: Statement
+			reduce(25), // wait, reduce: Statement
+			reduce(25), // for, reduce: Statement
+			reduce(25), // break, reduce: Statement
+			reduce(25), // continue, reduce: Statement
+			reduce(25), // return, reduce: Statement
+			reduce(25), // error, reduce: Statement
+			reduce(25), // if, reduce: Statement
 			nil,        // else
+			nil,        // =>
+			nil,        // _
+			nil,        // ||
+			nil,        // &&
+			nil,        // !
+			nil,        // and
+			nil,        // mul
+			nil,        // intLit
 			nil,        // plus
 			nil,        // minus
-			nil,        // mul
-			nil,        // and
 			nil,        // ==
 			nil,        // !=
 			nil,        // <
-		},
-	},
-	actionRow{ // S117
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // lbrace
-			reduce(6), // rbrace, reduce: Statement
-			reduce(6), // @, reduce: Statement
-			reduce(6), // identifier, reduce: Statement
-			nil,       // assign
-			nil,       // terminator
-			nil,       // [
-			nil,       // intLit
-			nil,       // ]
-			reduce(6), // if, reduce: Statement
-			reduce(6), // while, reduce: Statement
-			reduce(6), // wait, reduce: Statement
-			nil,       // (
-			nil,       // )
-			nil,       // else
-			nil,       // plus
-			nil,       // minus
-			nil,       // mul
-			nil,       // and
-			nil,       // ==
-			nil,       // !=
-			nil,       // <
+			nil,        // >
+			nil,        // <=
+			nil,        // >=
 		},
 	},
 }
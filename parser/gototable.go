@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 9
+const numNTSymbols = 27
 
 type (
 	gotoTable [numStates]gotoRow
@@ -13,1299 +13,12181 @@ var gotoTab = gotoTable{
 	gotoRow{ // S0
 		-1, // S'
 		1,  // Program
-		2,  // Statements
+		2,  // TopStatements
+		4,  // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
 		-1, // StatementBlock
-		-1, // Statement
+		3,  // Statement
+		9,  // BadStatement
+		10, // SimpleStatement
+		12, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S1
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S2
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		23, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
-		3,  // Statement
+		22, // Statement
+		9,  // BadStatement
+		10, // SimpleStatement
+		12, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S3
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S4
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S5
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		25, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S6
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		13, // Expression
-		15, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S7
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		31, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		16, // Expression
-		15, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S8
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S9
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S10
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		21, // Expression
-		23, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S11
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		25, // Expression
-		27, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S12
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S13
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
-		29, // StatementBlock
+		-1, // StatementBlock
 		-1, // Statement
+		39, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		31, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		38, // Expression
+		40, // Conjunction
+		41, // Negation
+		43, // Comparison
+		44, // BitwiseAnd
+		45, // Sum
+		46, // Product
+		47, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S14
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		50, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		49, // Expression
+		40, // Conjunction
+		41, // Negation
+		43, // Comparison
+		44, // BitwiseAnd
+		45, // Sum
+		46, // Product
+		47, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S15
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S16
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
-		39, // StatementBlock
+		-1, // StatementBlock
 		-1, // Statement
+		53, // BadStatement
+		54, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		31, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S17
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S18
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		42, // Expression
-		23, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S19
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		60, // Expression
+		62, // Conjunction
+		63, // Negation
+		65, // Comparison
+		66, // BitwiseAnd
+		67, // Sum
+		68, // Product
+		69, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S20
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S21
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		72, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		46, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		71, // Expression
+		40, // Conjunction
+		41, // Negation
+		43, // Comparison
+		44, // BitwiseAnd
+		45, // Sum
+		46, // Product
+		47, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S22
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S23
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S24
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
-		-1, // StatementBlock
+		73, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S25
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
-		-1, // StatementBlock
+		75, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		49, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S26
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S27
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		79, // Arguments
+		80, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		78, // Expression
+		81, // Conjunction
+		82, // Negation
+		84, // Comparison
+		85, // BitwiseAnd
+		86, // Sum
+		87, // Product
+		88, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S28
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		50, // Expression
-		27, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		90, // Expression
+		62, // Conjunction
+		63, // Negation
+		65, // Comparison
+		66, // BitwiseAnd
+		67, // Sum
+		68, // Product
+		69, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S29
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		51, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		-1, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		93,  // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S30
 		-1, // S'
 		-1, // Program
-		53, // Statements
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S31
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		-1, // ElseBlock
-		-1, // Expression
-		54, // Term
-		-1, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		104, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S32
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S33
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S34
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S35
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S36
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S37
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		-1, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		112, // Expression
+		113, // Conjunction
+		114, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S38
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S39
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S40
 		-1, // S'
 		-1, // Program
-		55, // Statements
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S41
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S42
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		46, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		126, // Negation
+		43,  // Comparison
+		44,  // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S43
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		-1, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		127, // CompareOperation
 	},
 	gotoRow{ // S44
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		59, // Expression
-		27, // Term
-		-1, // Operation
-	},
-	gotoRow{ // S45
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S45
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		135, // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S46
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
-		60, // Term
-		-1, // Operation
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S47
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		61, // Expression
-		27, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S48
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S49
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		-1, // ElseBlock
-		-1, // Expression
-		63, // Term
-		-1, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		139, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S50
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		49, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		140, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S51
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		-1, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		141, // Expression
+		113, // Conjunction
+		114, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S52
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
-		65, // StatementBlock
+		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S53
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		66, // Statement
-		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		-1, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		142, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S54
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S55
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
-		66, // Statement
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S56
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S57
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S58
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S59
-		-1, // S'
-		-1, // Program
-		-1, // Statements
-		-1, // StatementBlock
-		-1, // Statement
-		-1, // ElseBlock
-		-1, // Expression
-		-1, // Term
-		49, // Operation
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		147, // Expression
+		113, // Conjunction
+		114, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
 	gotoRow{ // S60
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S61
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		49, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S62
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		78, // Expression
-		23, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S63
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 	gotoRow{ // S64
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		151, // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S65
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		152, // CompareOperation
+	},
+	gotoRow{ // S66
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S65
+	gotoRow{ // S67
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		154, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S68
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S66
+	gotoRow{ // S69
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S67
+	gotoRow{ // S70
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S68
+	gotoRow{ // S71
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		156, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S72
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		158, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S73
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S69
+	gotoRow{ // S74
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		159, // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S75
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S76
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S77
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		162, // Expression
+		113, // Conjunction
+		114, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S78
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S79
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S80
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S81
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S82
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S83
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		167, // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S84
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		168, // CompareOperation
+	},
+	gotoRow{ // S85
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S86
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		170, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S87
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S88
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S89
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S90
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S91
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S92
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		174, // Expression
+		113, // Conjunction
+		114, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S93
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S94
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S95
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S96
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		178, // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S97
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		179, // CompareOperation
+	},
+	gotoRow{ // S98
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S99
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		181, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S100
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S101
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S102
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S103
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		184, // Parameters
+		185, // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S104
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S105
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		186, // Expression
+		62,  // Conjunction
+		63,  // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S106
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		187, // Expression
+		62,  // Conjunction
+		63,  // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S107
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		188, // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S108
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		189, // Arguments
+		80,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		78,  // Expression
+		81,  // Conjunction
+		82,  // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S109
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		190, // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S110
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S111
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		193, // Expression
+		113, // Conjunction
+		114, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S112
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S113
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S114
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S115
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		197, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S116
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		198, // CompareOperation
+	},
+	gotoRow{ // S117
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S118
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		200, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S119
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S120
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S121
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S122
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		202, // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S123
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		203, // Conjunction
+		41,  // Negation
+		43,  // Comparison
+		44,  // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S124
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		204, // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S125
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		205, // Negation
+		43,  // Comparison
+		44,  // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S126
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S127
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		206, // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S128
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S129
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S130
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S131
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S132
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S133
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S134
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		207, // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S135
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		208, // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S136
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S137
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S138
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		209, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S139
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S140
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S141
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S142
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S143
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		211, // Expression
+		62,  // Conjunction
+		63,  // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S144
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S145
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		212, // Arguments
+		80,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		78,  // Expression
+		81,  // Conjunction
+		82,  // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S146
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		213, // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S147
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S148
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S149
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		215, // Conjunction
+		63,  // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S150
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		216, // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S151
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S152
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		217, // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S153
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		218, // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S154
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		219, // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S155
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		220, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S156
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		221, // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S157
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		223, // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S158
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		224, // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S159
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		225, // Statement
+		227, // BadStatement
+		229, // SimpleStatement
+		231, // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S160
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		241, // Arguments
+		80,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		78,  // Expression
+		81,  // Conjunction
+		82,  // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S161
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		242, // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S162
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S163
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		244, // Conjunction
+		82,  // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S165
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		246, // Expression
+		81,  // Conjunction
+		82,  // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S166
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		247, // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S167
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S168
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		248, // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S169
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		249, // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S170
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		250, // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S171
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		251, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S172
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		252, // Arguments
+		80,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		78,  // Expression
+		81,  // Conjunction
+		82,  // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S173
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		253, // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S174
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S175
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S176
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		256, // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S177
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		257, // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S178
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S179
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		258, // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S180
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		259, // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S181
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		260, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S182
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		261, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S183
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S184
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S185
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S186
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S187
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S188
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S189
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S190
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S191
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		268, // Arguments
+		80,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		78,  // Expression
+		81,  // Conjunction
+		82,  // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S192
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		269, // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S193
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S194
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S195
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		271, // Conjunction
+		114, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S196
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		272, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S197
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S198
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		273, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S199
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		274, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S200
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		275, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S201
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		276, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S202
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		281, // MatchArm
+		279, // Expression
+		283, // Conjunction
+		284, // Negation
+		286, // Comparison
+		287, // BitwiseAnd
+		288, // Sum
+		289, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S203
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S204
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		281, // MatchArm
+		279, // Expression
+		283, // Conjunction
+		284, // Negation
+		286, // Comparison
+		287, // BitwiseAnd
+		288, // Sum
+		289, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S205
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S206
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S207
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		135, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S208
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S209
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S210
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S211
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S212
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S213
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S214
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S215
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S216
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S217
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S218
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		154, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S219
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S220
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S221
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S222
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		297, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		298, // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S223
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		225, // Statement
+		299, // BadStatement
+		229, // SimpleStatement
+		231, // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S224
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S225
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S226
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S227
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S228
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S229
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S230
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S231
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S232
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		307, // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		306, // Expression
+		40,  // Conjunction
+		41,  // Negation
+		43,  // Comparison
+		44,  // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S233
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		309, // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		308, // Expression
+		40,  // Conjunction
+		41,  // Negation
+		43,  // Comparison
+		44,  // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S234
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S235
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		311, // BadStatement
+		312, // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S236
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S237
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S238
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		315, // Expression
+		62,  // Conjunction
+		63,  // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S239
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S240
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		318, // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		317, // Expression
+		40,  // Conjunction
+		41,  // Negation
+		43,  // Comparison
+		44,  // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S241
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S242
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S243
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S244
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S245
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S246
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S247
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S248
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S249
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		170, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S250
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S251
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S252
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S253
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S254
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S255
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		323, // Expression
+		62,  // Conjunction
+		63,  // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S256
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S257
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S258
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S259
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		181, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S260
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S261
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S262
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		324, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S263
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S264
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S265
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S266
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S267
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S268
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S269
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S270
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S271
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S272
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S273
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S274
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		200, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S275
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S276
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S277
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S278
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		331, // Expression
+		113, // Conjunction
+		114, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S279
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S280
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S281
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S282
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S283
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S284
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S285
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		336, // Negation
+		286, // Comparison
+		287, // BitwiseAnd
+		288, // Sum
+		289, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S286
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		337, // CompareOperation
+	},
+	gotoRow{ // S287
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S288
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		339, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S289
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S290
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S291
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S292
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S293
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S294
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		342, // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S295
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S296
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S297
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S298
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S299
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S300
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S301
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		345, // Arguments
+		80,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		78,  // Expression
+		81,  // Conjunction
+		82,  // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S302
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S303
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S304
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S305
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S306
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S307
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S308
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		349, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S309
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		351, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S310
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		352, // Expression
+		113, // Conjunction
+		114, // Negation
+		116, // Comparison
+		117, // BitwiseAnd
+		118, // Sum
+		119, // Product
+		120, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S311
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		353, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S312
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S313
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S314
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S315
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S316
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S317
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		356, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S318
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		358, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S319
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S320
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S321
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S322
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S323
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S324
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S325
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S326
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		359, // Expression
+		62,  // Conjunction
+		63,  // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S327
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S328
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S329
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		360, // Arguments
+		80,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		78,  // Expression
+		81,  // Conjunction
+		82,  // Negation
+		84,  // Comparison
+		85,  // BitwiseAnd
+		86,  // Sum
+		87,  // Product
+		88,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S330
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		361, // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S331
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S332
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		363, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S333
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		365, // Conjunction
+		284, // Negation
+		286, // Comparison
+		287, // BitwiseAnd
+		288, // Sum
+		289, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S334
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		366, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S335
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		367, // Negation
+		286, // Comparison
+		287, // BitwiseAnd
+		288, // Sum
+		289, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S336
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S337
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		368, // BitwiseAnd
+		288, // Sum
+		289, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S338
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		369, // Sum
+		289, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S339
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		370, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S340
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		371, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S341
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S342
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		374, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S343
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S344
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S345
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S346
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		377, // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S347
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		378, // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S348
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		379, // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S349
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S350
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		380, // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S351
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S70
+	gotoRow{ // S352
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		82, // Expression
-		15, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S71
+	gotoRow{ // S353
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		83, // Expression
-		15, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S72
+	gotoRow{ // S354
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		382, // Expression
+		62,  // Conjunction
+		63,  // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S355
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S73
+	gotoRow{ // S356
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		383, // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S357
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		385, // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S358
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		386, // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S359
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S74
+	gotoRow{ // S360
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S75
+	gotoRow{ // S361
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S76
+	gotoRow{ // S362
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S77
+	gotoRow{ // S363
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S78
+	gotoRow{ // S364
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		390, // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S365
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		46, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S79
+	gotoRow{ // S366
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S80
+	gotoRow{ // S367
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		89, // Expression
-		23, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S81
+	gotoRow{ // S368
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		90, // Expression
-		27, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S82
+	gotoRow{ // S369
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		339, // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S370
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
-		91, // StatementBlock
+		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		31, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S83
+	gotoRow{ // S371
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
-		93, // StatementBlock
+		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		31, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S84
+	gotoRow{ // S372
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		391, // Expression
+		40,  // Conjunction
+		41,  // Negation
+		43,  // Comparison
+		44,  // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S373
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		392, // Expression
+		94,  // Conjunction
+		95,  // Negation
+		97,  // Comparison
+		98,  // BitwiseAnd
+		99,  // Sum
+		100, // Product
+		101, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S374
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S85
+	gotoRow{ // S375
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S86
+	gotoRow{ // S376
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S87
+	gotoRow{ // S377
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
-		97, // Expression
-		23, // Term
-		-1, // Operation
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S88
+	gotoRow{ // S378
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		281, // MatchArm
+		279, // Expression
+		283, // Conjunction
+		284, // Negation
+		286, // Comparison
+		287, // BitwiseAnd
+		288, // Sum
+		289, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S379
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		281, // MatchArm
+		279, // Expression
+		283, // Conjunction
+		284, // Negation
+		286, // Comparison
+		287, // BitwiseAnd
+		288, // Sum
+		289, // Product
+		290, // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S380
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		225, // Statement
+		398, // BadStatement
+		229, // SimpleStatement
+		231, // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S381
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S89
+	gotoRow{ // S382
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		46, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S90
+	gotoRow{ // S383
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		49, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S91
+	gotoRow{ // S384
 		-1,  // S'
 		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
 		-1,  // Statements
-		-1,  // StatementBlock
+		402, // StatementBlock
 		-1,  // Statement
-		101, // ElseBlock
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		403, // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
 		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
 		-1,  // Term
-		-1,  // Operation
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
-	gotoRow{ // S92
+	gotoRow{ // S385
 		-1,  // S'
 		-1,  // Program
-		103, // Statements
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
 		-1,  // StatementBlock
-		-1,  // Statement
+		225, // Statement
+		404, // BadStatement
+		229, // SimpleStatement
+		231, // IfStatement
 		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
 		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
 		-1,  // Term
-		-1,  // Operation
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
-	gotoRow{ // S93
+	gotoRow{ // S386
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S94
+	gotoRow{ // S387
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S388
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S389
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S390
 		-1,  // S'
 		-1,  // Program
-		104, // Statements
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
 		-1,  // StatementBlock
-		-1,  // Statement
+		225, // Statement
+		406, // BadStatement
+		229, // SimpleStatement
+		231, // IfStatement
 		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
 		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
 		-1,  // Term
-		-1,  // Operation
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
-	gotoRow{ // S95
+	gotoRow{ // S391
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S96
+	gotoRow{ // S392
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S97
+	gotoRow{ // S393
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		409, // Expression
+		40,  // Conjunction
+		41,  // Negation
+		43,  // Comparison
+		44,  // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S394
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		46, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S98
+	gotoRow{ // S395
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S99
+	gotoRow{ // S396
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S100
+	gotoRow{ // S397
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S101
+	gotoRow{ // S398
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S102
+	gotoRow{ // S399
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S400
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
+	},
+	gotoRow{ // S401
 		-1,  // S'
 		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
 		-1,  // Statements
-		109, // StatementBlock
+		-1,  // StatementBlock
 		-1,  // Statement
+		-1,  // BadStatement
+		412, // SimpleStatement
+		-1,  // IfStatement
 		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
 		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
 		-1,  // Term
-		-1,  // Operation
+		-1,  // AddOperation
+		-1,  // CompareOperation
 	},
-	gotoRow{ // S103
+	gotoRow{ // S402
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
-		66, // Statement
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S104
+	gotoRow{ // S403
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
-		66, // Statement
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S105
+	gotoRow{ // S404
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S106
+	gotoRow{ // S405
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S107
+	gotoRow{ // S406
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S108
-		-1,  // S'
-		-1,  // Program
-		-1,  // Statements
-		-1,  // StatementBlock
-		-1,  // Statement
-		-1,  // ElseBlock
-		114, // Expression
-		23,  // Term
-		-1,  // Operation
+	gotoRow{ // S407
+		-1, // S'
+		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
+		-1, // Statements
+		-1, // StatementBlock
+		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
+		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
+		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
+		-1, // Term
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S109
+	gotoRow{ // S408
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S110
+	gotoRow{ // S409
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S111
+	gotoRow{ // S410
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		416, // Expression
+		62,  // Conjunction
+		63,  // Negation
+		65,  // Comparison
+		66,  // BitwiseAnd
+		67,  // Sum
+		68,  // Product
+		69,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S411
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S112
+	gotoRow{ // S412
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		417, // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		-1,  // Expression
+		-1,  // Conjunction
+		-1,  // Negation
+		-1,  // Comparison
+		-1,  // BitwiseAnd
+		-1,  // Sum
+		-1,  // Product
+		-1,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S413
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S113
+	gotoRow{ // S414
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S114
+	gotoRow{ // S415
+		-1,  // S'
+		-1,  // Program
+		-1,  // TopStatements
+		-1,  // Declaration
+		-1,  // Parameters
+		-1,  // ParameterList
+		-1,  // Arguments
+		-1,  // ArgumentList
+		-1,  // Statements
+		-1,  // StatementBlock
+		-1,  // Statement
+		-1,  // BadStatement
+		-1,  // SimpleStatement
+		-1,  // IfStatement
+		-1,  // ElseBlock
+		-1,  // MatchArms
+		-1,  // MatchArm
+		418, // Expression
+		40,  // Conjunction
+		41,  // Negation
+		43,  // Comparison
+		44,  // BitwiseAnd
+		45,  // Sum
+		46,  // Product
+		47,  // Term
+		-1,  // AddOperation
+		-1,  // CompareOperation
+	},
+	gotoRow{ // S416
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		46, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S115
+	gotoRow{ // S417
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S116
+	gotoRow{ // S418
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
-	gotoRow{ // S117
+	gotoRow{ // S419
 		-1, // S'
 		-1, // Program
+		-1, // TopStatements
+		-1, // Declaration
+		-1, // Parameters
+		-1, // ParameterList
+		-1, // Arguments
+		-1, // ArgumentList
 		-1, // Statements
 		-1, // StatementBlock
 		-1, // Statement
+		-1, // BadStatement
+		-1, // SimpleStatement
+		-1, // IfStatement
 		-1, // ElseBlock
+		-1, // MatchArms
+		-1, // MatchArm
 		-1, // Expression
+		-1, // Conjunction
+		-1, // Negation
+		-1, // Comparison
+		-1, // BitwiseAnd
+		-1, // Sum
+		-1, // Product
 		-1, // Term
-		-1, // Operation
+		-1, // AddOperation
+		-1, // CompareOperation
 	},
 }
@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 26
-	numStates      = 118
-	numSymbols     = 34
+	numProductions = 80
+	numStates      = 420
+	numSymbols     = 70
 )
 
 // Stack
@@ -32,7 +32,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Program : Statements	<< ast.NewProgram(X[0]) >>`,
+		String: `Program : TopStatements	<< ast.NewProgram(X[0]) >>`,
 		Id:         "Program",
 		NTType:     1,
 		Index:      1,
@@ -41,11 +41,191 @@ var productionsTable = ProdTab{
 			return ast.NewProgram(X[0])
 		},
 	},
+	ProdTabEntry{
+		String: `Program : empty	<< ast.NewProgram(nil) >>`,
+		Id:         "Program",
+		NTType:     1,
+		Index:      2,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewProgram(nil)
+		},
+	},
+	ProdTabEntry{
+		String: `TopStatements : TopStatements Statement	<< ast.AppendStatement(X[0], X[1]) >>`,
+		Id:         "TopStatements",
+		NTType:     2,
+		Index:      3,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendStatement(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TopStatements : TopStatements Declaration	<< ast.AppendStatement(X[0], X[1]) >>`,
+		Id:         "TopStatements",
+		NTType:     2,
+		Index:      4,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendStatement(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `TopStatements : Statement	<< ast.AppendStatement(nil, X[0]) >>`,
+		Id:         "TopStatements",
+		NTType:     2,
+		Index:      5,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendStatement(nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `TopStatements : Declaration	<< ast.AppendStatement(nil, X[0]) >>`,
+		Id:         "TopStatements",
+		NTType:     2,
+		Index:      6,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendStatement(nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Declaration : "proc" identifier StatementBlock	<< ast.NewProcStatement(X[0], X[1], X[2]) >>`,
+		Id:         "Declaration",
+		NTType:     3,
+		Index:      7,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewProcStatement(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Declaration : "fn" identifier "(" Parameters ")" StatementBlock	<< ast.NewFnStatement(X[0], X[1], X[3], X[5]) >>`,
+		Id:         "Declaration",
+		NTType:     3,
+		Index:      8,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFnStatement(X[0], X[1], X[3], X[5])
+		},
+	},
+	ProdTabEntry{
+		String: `Declaration : "const" identifier assign Expression terminator	<< ast.NewConstStatement(X[0], X[1], X[3]) >>`,
+		Id:         "Declaration",
+		NTType:     3,
+		Index:      9,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewConstStatement(X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Declaration : "proc" BadStatement StatementBlock	<< X[1], nil >>`,
+		Id:         "Declaration",
+		NTType:     3,
+		Index:      10,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Declaration : "fn" BadStatement StatementBlock	<< X[1], nil >>`,
+		Id:         "Declaration",
+		NTType:     3,
+		Index:      11,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Parameters : ParameterList	<<  >>`,
+		Id:         "Parameters",
+		NTType:     4,
+		Index:      12,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Parameters : empty	<< ast.NewIdentifierList() >>`,
+		Id:         "Parameters",
+		NTType:     4,
+		Index:      13,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIdentifierList()
+		},
+	},
+	ProdTabEntry{
+		String: `ParameterList : ParameterList "," identifier	<< ast.AppendIdentifier(X[0], X[2]) >>`,
+		Id:         "ParameterList",
+		NTType:     5,
+		Index:      14,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendIdentifier(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ParameterList : identifier	<< ast.AppendIdentifier(nil, X[0]) >>`,
+		Id:         "ParameterList",
+		NTType:     5,
+		Index:      15,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendIdentifier(nil, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Arguments : ArgumentList	<<  >>`,
+		Id:         "Arguments",
+		NTType:     6,
+		Index:      16,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Arguments : empty	<< ast.NewExpressionList() >>`,
+		Id:         "Arguments",
+		NTType:     6,
+		Index:      17,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewExpressionList()
+		},
+	},
+	ProdTabEntry{
+		String: `ArgumentList : ArgumentList "," Expression	<< ast.AppendExpression(X[0], X[2]) >>`,
+		Id:         "ArgumentList",
+		NTType:     7,
+		Index:      18,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendExpression(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ArgumentList : Expression	<< ast.AppendExpression(nil, X[0]) >>`,
+		Id:         "ArgumentList",
+		NTType:     7,
+		Index:      19,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendExpression(nil, X[0])
+		},
+	},
 	ProdTabEntry{
 		String: `Statements : Statements Statement	<< ast.AppendStatement(X[0], X[1]) >>`,
 		Id:         "Statements",
-		NTType:     2,
-		Index:      2,
+		NTType:     8,
+		Index:      20,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.AppendStatement(X[0], X[1])
@@ -54,128 +234,458 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Statements : empty	<< ast.NewStatementList() >>`,
 		Id:         "Statements",
-		NTType:     2,
-		Index:      3,
+		NTType:     8,
+		Index:      21,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewStatementList()
 		},
 	},
 	ProdTabEntry{
-		String: `StatementBlock : lbrace Statements rbrace	<< ast.NewBlockStatement(X[1]) >>`,
+		String: `StatementBlock : lbrace Statements rbrace	<< ast.NewBlockStatement(X[0], X[1], X[2]) >>`,
 		Id:         "StatementBlock",
-		NTType:     3,
-		Index:      4,
+		NTType:     9,
+		Index:      22,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewBlockStatement(X[1])
+			return ast.NewBlockStatement(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `StatementBlock : lbrace Statements BadStatement rbrace	<< ast.NewRecoveredBlockStatement(X[0], X[1], X[2], X[3]) >>`,
+		Id:         "StatementBlock",
+		NTType:     9,
+		Index:      23,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewRecoveredBlockStatement(X[0], X[1], X[2], X[3])
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : "@" identifier assign Expression terminator	<< ast.NewIdentInit(X[1], X[3]) >>`,
+		String: `Statement : SimpleStatement terminator	<< X[0], nil >>`,
 		Id:         "Statement",
-		NTType:     4,
-		Index:      5,
-		NumSymbols: 5,
+		NTType:     10,
+		Index:      24,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewIdentInit(X[1], X[3])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : "@" identifier "[" intLit "]" assign intLit terminator	<< ast.NewTabInit(X[1], X[3], X[6]) >>`,
+		String: `Statement : "@" identifier "[" Expression "]" assign Expression terminator	<< ast.NewTabInit(X[0], X[1], X[3], X[6]) >>`,
 		Id:         "Statement",
-		NTType:     4,
-		Index:      6,
+		NTType:     10,
+		Index:      25,
 		NumSymbols: 8,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewTabInit(X[1], X[3], X[6])
+			return ast.NewTabInit(X[0], X[1], X[3], X[6])
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : "if" Expression StatementBlock ElseBlock	<< ast.NewIfStatement(X[1], X[2], X[3]) >>`,
+		String: `Statement : IfStatement	<<  >>`,
 		Id:         "Statement",
-		NTType:     4,
-		Index:      7,
-		NumSymbols: 4,
+		NTType:     10,
+		Index:      26,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewIfStatement(X[1], X[2], X[3])
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : "while" Expression StatementBlock	<< ast.NewWhileStatement(X[1], X[2]) >>`,
+		String: `Statement : "match" Expression lbrace MatchArms rbrace	<< ast.NewMatchStatement(X[0], X[1], X[3], X[4]) >>`,
 		Id:         "Statement",
-		NTType:     4,
-		Index:      8,
+		NTType:     10,
+		Index:      27,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewMatchStatement(X[0], X[1], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : "match" BadStatement lbrace MatchArms rbrace	<< X[1], nil >>`,
+		Id:         "Statement",
+		NTType:     10,
+		Index:      28,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : "while" Expression StatementBlock	<< ast.NewWhileStatement(X[0], X[1], X[2]) >>`,
+		Id:         "Statement",
+		NTType:     10,
+		Index:      29,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewWhileStatement(X[1], X[2])
+			return ast.NewWhileStatement(X[0], X[1], X[2])
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : identifier assign Expression terminator	<< ast.NewAssignStatement(X[0], X[2]) >>`,
+		String: `Statement : "while" BadStatement StatementBlock	<< X[1], nil >>`,
 		Id:         "Statement",
-		NTType:     4,
-		Index:      9,
-		NumSymbols: 4,
+		NTType:     10,
+		Index:      30,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewAssignStatement(X[0], X[2])
+			return X[1], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : "wait" "(" intLit ")" terminator	<< ast.NewWaitStatement(X[2]) >>`,
+		String: `Statement : "wait" "(" Expression ")" terminator	<< ast.NewWaitStatement(X[0], X[2], X[3]) >>`,
 		Id:         "Statement",
-		NTType:     4,
-		Index:      10,
+		NTType:     10,
+		Index:      31,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewWaitStatement(X[2])
+			return ast.NewWaitStatement(X[0], X[2], X[3])
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : identifier "[" Expression "]" assign Expression terminator	<< ast.NewAssignTabStatement(X[0], X[2], X[5]) >>`,
+		String: `Statement : "for" SimpleStatement terminator Expression terminator SimpleStatement StatementBlock	<< ast.NewForStatement(X[0], X[1], X[3], X[5], X[6]) >>`,
 		Id:         "Statement",
-		NTType:     4,
-		Index:      11,
+		NTType:     10,
+		Index:      32,
 		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewForStatement(X[0], X[1], X[3], X[5], X[6])
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : "for" BadStatement StatementBlock	<< X[1], nil >>`,
+		Id:         "Statement",
+		NTType:     10,
+		Index:      33,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : "break" terminator	<< ast.NewBreakStatement(X[0]) >>`,
+		Id:         "Statement",
+		NTType:     10,
+		Index:      34,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewBreakStatement(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : "continue" terminator	<< ast.NewContinueStatement(X[0]) >>`,
+		Id:         "Statement",
+		NTType:     10,
+		Index:      35,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewContinueStatement(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : identifier "(" Arguments ")" terminator	<< ast.NewCallStatement(X[0], X[2], X[3]) >>`,
+		Id:         "Statement",
+		NTType:     10,
+		Index:      36,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCallStatement(X[0], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : "return" terminator	<< ast.NewReturnStatement(X[0], nil) >>`,
+		Id:         "Statement",
+		NTType:     10,
+		Index:      37,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnStatement(X[0], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : "return" Expression terminator	<< ast.NewReturnStatement(X[0], X[1]) >>`,
+		Id:         "Statement",
+		NTType:     10,
+		Index:      38,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewReturnStatement(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : BadStatement terminator	<< X[0], nil >>`,
+		Id:         "Statement",
+		NTType:     10,
+		Index:      39,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BadStatement : error	<< ast.NewBadStatement(C, X[0]) >>`,
+		Id:         "BadStatement",
+		NTType:     11,
+		Index:      40,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewBadStatement(C, X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `SimpleStatement : "@" identifier assign Expression	<< ast.NewIdentInit(X[0], X[1], X[3]) >>`,
+		Id:         "SimpleStatement",
+		NTType:     12,
+		Index:      41,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIdentInit(X[0], X[1], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `SimpleStatement : identifier assign Expression	<< ast.NewAssignStatement(X[0], X[2]) >>`,
+		Id:         "SimpleStatement",
+		NTType:     12,
+		Index:      42,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewAssignStatement(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `SimpleStatement : identifier "[" Expression "]" assign Expression	<< ast.NewAssignTabStatement(X[0], X[2], X[5]) >>`,
+		Id:         "SimpleStatement",
+		NTType:     12,
+		Index:      43,
+		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewAssignTabStatement(X[0], X[2], X[5])
 		},
 	},
+	ProdTabEntry{
+		String: `IfStatement : "if" Expression StatementBlock ElseBlock	<< ast.NewIfStatement(X[0], X[1], X[2], X[3]) >>`,
+		Id:         "IfStatement",
+		NTType:     13,
+		Index:      44,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewIfStatement(X[0], X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `IfStatement : "if" BadStatement StatementBlock ElseBlock	<< X[1], nil >>`,
+		Id:         "IfStatement",
+		NTType:     13,
+		Index:      45,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
 	ProdTabEntry{
 		String: `ElseBlock : "else" StatementBlock	<< X[1], nil >>`,
 		Id:         "ElseBlock",
-		NTType:     5,
-		Index:      12,
+		NTType:     14,
+		Index:      46,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
 		},
 	},
+	ProdTabEntry{
+		String: `ElseBlock : "else" IfStatement	<< ast.NewElseIfBlock(X[1]) >>`,
+		Id:         "ElseBlock",
+		NTType:     14,
+		Index:      47,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewElseIfBlock(X[1])
+		},
+	},
 	ProdTabEntry{
 		String: `ElseBlock : empty	<<  >>`,
 		Id:         "ElseBlock",
-		NTType:     5,
-		Index:      13,
+		NTType:     14,
+		Index:      48,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expression : Expression Operation Term	<< ast.NewInfixExpression(X[0], X[2], X[1]) >>`,
+		String: `MatchArms : MatchArms MatchArm	<< ast.AppendMatchArm(X[0], X[1]) >>`,
+		Id:         "MatchArms",
+		NTType:     15,
+		Index:      49,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.AppendMatchArm(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchArms : empty	<< ast.NewMatchArmList() >>`,
+		Id:         "MatchArms",
+		NTType:     15,
+		Index:      50,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewMatchArmList()
+		},
+	},
+	ProdTabEntry{
+		String: `MatchArm : Expression "=>" StatementBlock	<< ast.NewMatchArm(X[0], X[1], X[2]) >>`,
+		Id:         "MatchArm",
+		NTType:     16,
+		Index:      51,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewMatchArm(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `MatchArm : "_" "=>" StatementBlock	<< ast.NewMatchArm(nil, X[1], X[2]) >>`,
+		Id:         "MatchArm",
+		NTType:     16,
+		Index:      52,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewMatchArm(nil, X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expression : Expression "||" Conjunction	<< ast.NewInfixExpression(X[0], X[2], X[1]) >>`,
 		Id:         "Expression",
-		NTType:     6,
-		Index:      14,
+		NTType:     17,
+		Index:      53,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewInfixExpression(X[0], X[2], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `Expression : Term	<<  >>`,
+		String: `Expression : Conjunction	<<  >>`,
 		Id:         "Expression",
-		NTType:     6,
-		Index:      15,
+		NTType:     17,
+		Index:      54,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Conjunction : Conjunction "&&" Negation	<< ast.NewInfixExpression(X[0], X[2], X[1]) >>`,
+		Id:         "Conjunction",
+		NTType:     18,
+		Index:      55,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewInfixExpression(X[0], X[2], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Conjunction : Negation	<<  >>`,
+		Id:         "Conjunction",
+		NTType:     18,
+		Index:      56,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Negation : "!" Negation	<< ast.NewPrefixExpression(X[0], X[1]) >>`,
+		Id:         "Negation",
+		NTType:     19,
+		Index:      57,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewPrefixExpression(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Negation : Comparison	<<  >>`,
+		Id:         "Negation",
+		NTType:     19,
+		Index:      58,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Comparison : Comparison CompareOperation BitwiseAnd	<< ast.NewInfixExpression(X[0], X[2], X[1]) >>`,
+		Id:         "Comparison",
+		NTType:     20,
+		Index:      59,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewInfixExpression(X[0], X[2], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Comparison : BitwiseAnd	<<  >>`,
+		Id:         "Comparison",
+		NTType:     20,
+		Index:      60,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BitwiseAnd : BitwiseAnd and Sum	<< ast.NewInfixExpression(X[0], X[2], X[1]) >>`,
+		Id:         "BitwiseAnd",
+		NTType:     21,
+		Index:      61,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewInfixExpression(X[0], X[2], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `BitwiseAnd : Sum	<<  >>`,
+		Id:         "BitwiseAnd",
+		NTType:     21,
+		Index:      62,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Sum : Sum AddOperation Product	<< ast.NewInfixExpression(X[0], X[2], X[1]) >>`,
+		Id:         "Sum",
+		NTType:     22,
+		Index:      63,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewInfixExpression(X[0], X[2], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Sum : Product	<<  >>`,
+		Id:         "Sum",
+		NTType:     22,
+		Index:      64,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Product : Product mul Term	<< ast.NewInfixExpression(X[0], X[2], X[1]) >>`,
+		Id:         "Product",
+		NTType:     23,
+		Index:      65,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewInfixExpression(X[0], X[2], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Product : Term	<<  >>`,
+		Id:         "Product",
+		NTType:     23,
+		Index:      66,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -184,98 +694,128 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Term : intLit	<< ast.NewIntegerLiteral(X[0]) >>`,
 		Id:         "Term",
-		NTType:     7,
-		Index:      16,
+		NTType:     24,
+		Index:      67,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewIntegerLiteral(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Term : identifier "[" Expression "]"	<< ast.NewTabExpression(X[0], X[2]) >>`,
+		String: `Term : identifier "[" Expression "]"	<< ast.NewTabExpression(X[0], X[2], X[3]) >>`,
 		Id:         "Term",
-		NTType:     7,
-		Index:      17,
+		NTType:     24,
+		Index:      68,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return ast.NewTabExpression(X[0], X[2])
+			return ast.NewTabExpression(X[0], X[2], X[3])
 		},
 	},
 	ProdTabEntry{
 		String: `Term : identifier	<< ast.NewIdentExpression(X[0]) >>`,
 		Id:         "Term",
-		NTType:     7,
-		Index:      18,
+		NTType:     24,
+		Index:      69,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewIdentExpression(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Operation : plus	<<  >>`,
-		Id:         "Operation",
-		NTType:     8,
-		Index:      19,
+		String: `Term : identifier "(" Arguments ")"	<< ast.NewCallExpression(X[0], X[2], X[3]) >>`,
+		Id:         "Term",
+		NTType:     24,
+		Index:      70,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewCallExpression(X[0], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Term : "(" Expression ")"	<< X[1], nil >>`,
+		Id:         "Term",
+		NTType:     24,
+		Index:      71,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `AddOperation : plus	<<  >>`,
+		Id:         "AddOperation",
+		NTType:     25,
+		Index:      72,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Operation : minus	<<  >>`,
-		Id:         "Operation",
-		NTType:     8,
-		Index:      20,
+		String: `AddOperation : minus	<<  >>`,
+		Id:         "AddOperation",
+		NTType:     25,
+		Index:      73,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Operation : mul	<<  >>`,
-		Id:         "Operation",
-		NTType:     8,
-		Index:      21,
+		String: `CompareOperation : "=="	<<  >>`,
+		Id:         "CompareOperation",
+		NTType:     26,
+		Index:      74,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Operation : and	<<  >>`,
-		Id:         "Operation",
-		NTType:     8,
-		Index:      22,
+		String: `CompareOperation : "!="	<<  >>`,
+		Id:         "CompareOperation",
+		NTType:     26,
+		Index:      75,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Operation : "=="	<<  >>`,
-		Id:         "Operation",
-		NTType:     8,
-		Index:      23,
+		String: `CompareOperation : "<"	<<  >>`,
+		Id:         "CompareOperation",
+		NTType:     26,
+		Index:      76,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Operation : "!="	<<  >>`,
-		Id:         "Operation",
-		NTType:     8,
-		Index:      24,
+		String: `CompareOperation : ">"	<<  >>`,
+		Id:         "CompareOperation",
+		NTType:     26,
+		Index:      77,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Operation : "<"	<<  >>`,
-		Id:         "Operation",
-		NTType:     8,
-		Index:      25,
+		String: `CompareOperation : "<="	<<  >>`,
+		Id:         "CompareOperation",
+		NTType:     26,
+		Index:      78,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CompareOperation : ">="	<<  >>`,
+		Id:         "CompareOperation",
+		NTType:     26,
+		Index:      79,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -0,0 +1,599 @@
+package semantic
+
+import (
+	"fmt"
+	"minicompiler/ast"
+	"minicompiler/diag"
+	"minicompiler/token"
+	"strconv"
+	"strings"
+)
+
+// routine is a proc or fn declaration together with the calls made from its
+// body, which form the edges of the call graph, and the number of places it
+// is called from.
+type routine struct {
+	name       string
+	token      *token.Token
+	isFn       bool
+	params     []ast.Identifier
+	paramNames map[string]bool
+	block      *ast.BlockStatement
+	calls      []call
+	sites      int
+}
+
+type call struct {
+	callee string
+	token  *token.Token
+}
+
+// constant is a const declaration whose value is computed on first use, so
+// that constants may refer to constants declared further down.
+type constant struct {
+	decl  *ast.ConstStatement
+	value int
+	state int
+}
+
+// Limits of the target: data is stored in bytes, and the parameter of an
+// instruction is 16 bits wide. The call sites of a routine are numbered
+// from 1 in the byte its return dispatch reads.
+const (
+	maxData      = 0xFF
+	maxTableSize = maxData + 1
+	maxParam     = 0xFFFF
+	maxCallSites = maxData
+)
+
+const (
+	unresolved = iota
+	resolving
+	resolved
+	invalid
+)
+
+type checker struct {
+	routines  map[string]*routine
+	order     []*routine
+	constants map[string]*constant
+	globals   *scope
+	scope     *scope
+	labels    map[string]bool
+	current   *routine
+	loops     int
+	diags     []diag.Diagnostic
+}
+
+// Check runs the semantic analysis of program and returns every error found.
+// References to constants are replaced by integer literals holding their
+// value, and the sizes, default values and wait times given as constant
+// expressions are resolved. Variables and tables are resolved through a
+// symbol table and must be declared with @ before they are used. A
+// declaration is private to its block and may shadow an outer one, the data
+// label allocated for it is recorded in the Storage field of the nodes.
+func Check(program *ast.Program) []diag.Diagnostic {
+	c := &checker{routines: map[string]*routine{}, constants: map[string]*constant{}, labels: map[string]bool{}}
+	c.globals = newScope(universe())
+	c.scope = c.globals
+
+	for _, stmt := range program.Statements {
+		switch decl := stmt.(type) {
+		case *ast.ProcStatement:
+			c.declare(&routine{name: decl.Name, token: decl.Token, block: decl.Block})
+		case *ast.FnStatement:
+			c.declare(&routine{name: decl.Name, token: decl.Token, isFn: true, params: decl.Params, paramNames: c.checkParams(decl.Params), block: decl.Block})
+		case *ast.ConstStatement:
+			if _, exists := c.constants[decl.Name]; exists {
+				c.errorf(decl.Token, "constant %v is already declared", decl.Name)
+				continue
+			}
+			c.constants[decl.Name] = &constant{decl: decl}
+		}
+	}
+
+	for _, stmt := range program.Statements {
+		if decl, ok := stmt.(*ast.ConstStatement); ok && c.constants[decl.Name].decl == decl {
+			c.constValue(c.constants[decl.Name])
+		}
+	}
+
+	c.statements(program.Statements)
+	for _, r := range c.order {
+		c.current = r
+		c.loops = 0
+		c.scope = newScope(c.globals)
+		for i, param := range r.params {
+			if sym := c.scope.lookup(param.Value); sym != nil && sym.builtin {
+				c.errorf(param.Token, "%v is a builtin and cannot be declared", param.Value)
+			}
+			r.params[i].Storage = c.newStorage("arg_", param.Value)
+			c.scope.declare(&symbol{name: param.Value, kind: scalarSymbol, storage: r.params[i].Storage, token: param.Token})
+		}
+		c.statement(r.block)
+	}
+	c.current = nil
+	c.scope = c.globals
+
+	c.checkRecursion()
+
+	diag.Sort(c.diags)
+	return c.diags
+}
+
+func (c *checker) errorf(tok *token.Token, format string, args ...interface{}) {
+	c.diags = append(c.diags, diag.Errorf(tok, format, args...))
+}
+
+// nodeErrorf reports an error spanning the whole of n, for errors about
+// the value of an expression rather than about a name.
+func (c *checker) nodeErrorf(n ast.Node, format string, args ...interface{}) {
+	c.diags = append(c.diags, diag.NodeErrorf(n, format, args...))
+}
+
+func (c *checker) declare(r *routine) {
+	if _, exists := c.routines[r.name]; exists {
+		c.errorf(r.token, "%v is already declared", r.name)
+		return
+	}
+	c.routines[r.name] = r
+	c.order = append(c.order, r)
+}
+
+func (c *checker) checkParams(params []ast.Identifier) map[string]bool {
+	seen := map[string]bool{}
+	for _, param := range params {
+		if seen[param.Value] {
+			c.errorf(param.Token, "duplicate parameter %v", param.Value)
+		}
+		seen[param.Value] = true
+	}
+	return seen
+}
+
+func (c *checker) statements(stmts []ast.Statement) {
+	for _, stmt := range stmts {
+		c.statement(stmt)
+	}
+}
+
+func (c *checker) statement(stmt ast.Statement) {
+	switch node := stmt.(type) {
+	case *ast.InitStatement:
+		c.checkNotConstant(node.Token, node.Location)
+		node.Expr = c.expression(node.Expr)
+		node.Storage = c.declareVariable(node.Token, node.Location, scalarSymbol)
+	case *ast.TabInitStatement:
+		c.checkNotConstant(node.Token, node.Location)
+		node.Storage = c.declareVariable(node.Token, node.Location, tableSymbol)
+		if size, ok := c.evaluate(node.SizeExpr); ok {
+			switch {
+			case size <= 0:
+				c.nodeErrorf(node.SizeExpr, "size of table %v must be positive", node.Location)
+			case size > maxTableSize:
+				c.nodeErrorf(node.SizeExpr, "size %v of table %v exceeds the %v entries an 8-bit index can reach", size, node.Location, maxTableSize)
+			}
+			node.Size = size
+		}
+		if value, ok := c.evaluate(node.DefaultExpr); ok {
+			if value < 0 || value > maxData {
+				c.nodeErrorf(node.DefaultExpr, "default value %v of table %v does not fit in 8 bits (0 to %v)", value, node.Location, maxData)
+			}
+			node.DefaultValue = value
+		}
+	case *ast.AssignStatement:
+		if c.lookupConstant(node.Left.Value) != nil {
+			c.errorf(node.Left.Token, "cannot assign to constant %v", node.Left.Value)
+		} else {
+			node.Left.Storage = c.checkUse(node.Left.Token, node.Left.Value, scalarSymbol, true)
+		}
+		node.Right = c.expression(node.Right)
+	case *ast.AssignTabStatement:
+		if c.lookupConstant(node.Left.Value) != nil {
+			c.errorf(node.Left.Token, "cannot assign to constant %v", node.Left.Value)
+		} else {
+			node.Left.Storage = c.checkUse(node.Left.Token, node.Left.Value, tableSymbol, true)
+		}
+		node.Index = c.expression(node.Index)
+		node.Right = c.expression(node.Right)
+	case *ast.WaitStatement:
+		if time, ok := c.evaluate(node.TimeExpr); ok {
+			if time < 0 || time > maxParam {
+				c.nodeErrorf(node.TimeExpr, "wait time %v does not fit in the 16-bit instruction parameter (0 to %v)", time, maxParam)
+			}
+			node.Time = time
+		}
+	case *ast.BlockStatement:
+		c.scope = newScope(c.scope)
+		c.statements(node.Statements)
+		c.scope = c.scope.parent
+	case *ast.IfStatement:
+		node.Condition = c.expression(node.Condition)
+		c.statement(node.Block)
+		c.statement(node.Alternative)
+	case *ast.WhileStatement:
+		node.Condition = c.expression(node.Condition)
+		c.loopBlock(node.Block)
+	case *ast.ForStatement:
+		// a variable declared by the init statement is private to the loop
+		c.scope = newScope(c.scope)
+		c.statement(node.Init)
+		node.Condition = c.expression(node.Condition)
+		c.statement(node.Post)
+		c.loopBlock(node.Block)
+		c.scope = c.scope.parent
+	case *ast.BreakStatement:
+		if c.loops == 0 {
+			c.errorf(node.Token, "break outside of a loop")
+		}
+	case *ast.ContinueStatement:
+		if c.loops == 0 {
+			c.errorf(node.Token, "continue outside of a loop")
+		}
+	case *ast.MatchStatement:
+		c.matchStatement(node)
+	case *ast.CallStatement:
+		c.call(node.Token, node.Name, node.Args)
+	case *ast.ReturnStatement:
+		c.returnStatement(node)
+	}
+}
+
+func (c *checker) loopBlock(block *ast.BlockStatement) {
+	c.loops++
+	c.statement(block)
+	c.loops--
+}
+
+// matchStatement requires the patterns to be distinct constant expressions
+// and the default arm, if any, to come last.
+func (c *checker) matchStatement(node *ast.MatchStatement) {
+	node.Subject = c.expression(node.Subject)
+
+	seen := map[int]bool{}
+	for i, arm := range node.Arms {
+		if arm.Pattern == nil {
+			if i != len(node.Arms)-1 {
+				c.errorf(arm.Token, "the default arm _ must be the last arm of the match")
+			}
+		} else {
+			pattern := arm.Pattern
+			arm.Pattern = c.expression(arm.Pattern)
+			if value, ok := c.evaluate(arm.Pattern); ok {
+				if value < 0 || value > maxData {
+					c.nodeErrorf(pattern, "pattern %v does not fit in 8 bits (0 to %v)", value, maxData)
+				}
+				if seen[value] {
+					c.nodeErrorf(pattern, "duplicate pattern %v in match", value)
+				}
+				seen[value] = true
+				if _, isLiteral := arm.Pattern.(*ast.IntegerLiteral); !isLiteral {
+					arm.Pattern = &ast.IntegerLiteral{Token: arm.Token, Value: strconv.Itoa(value)}
+				}
+			}
+		}
+		c.statement(arm.Block)
+	}
+}
+
+// expression checks expr and returns it with every reference to a constant
+// replaced by its value.
+func (c *checker) expression(expr ast.Expression) ast.Expression {
+	switch node := expr.(type) {
+	case *ast.IntegerLiteral:
+		value, err := ast.ParseInteger(node.Value)
+		switch {
+		case err != nil:
+			c.errorf(node.Token, "invalid integer literal %v", node.Value)
+		case value < 0 || value > maxData:
+			c.errorf(node.Token, "integer %v does not fit in 8 bits (0 to %v)", node.Value, maxData)
+		}
+	case *ast.Identifier:
+		if k := c.lookupConstant(node.Value); k != nil {
+			value, ok := c.constValue(k)
+			if ok && value > maxData {
+				c.errorf(node.Token, "constant %v = %v does not fit in 8 bits (0 to %v)", node.Value, value, maxData)
+			}
+			return &ast.IntegerLiteral{Token: node.Token, Value: strconv.Itoa(value)}
+		}
+		node.Storage = c.checkUse(node.Token, node.Value, scalarSymbol, false)
+	case *ast.InfixExpression:
+		node.Left = c.expression(node.Left)
+		node.Right = c.expression(node.Right)
+	case *ast.PrefixExpression:
+		node.Right = c.expression(node.Right)
+	case *ast.TabExpression:
+		if c.lookupConstant(node.Ident.Value) != nil {
+			c.errorf(node.Token, "constant %v cannot be indexed", node.Ident.Value)
+		} else {
+			node.Ident.Storage = c.checkUse(node.Token, node.Ident.Value, tableSymbol, false)
+		}
+		node.Index = c.expression(node.Index)
+	case *ast.CallExpression:
+		if r := c.call(node.Token, node.Name, node.Args); r != nil && !r.isFn {
+			c.errorf(node.Token, "proc %v does not return a value", node.Name)
+		}
+	}
+	return expr
+}
+
+func (c *checker) call(tok *token.Token, name string, args []ast.Expression) *routine {
+	for i, arg := range args {
+		args[i] = c.expression(arg)
+	}
+
+	r, ok := c.routines[name]
+	if !ok {
+		c.errorf(tok, "call to undeclared proc or fn %v", name)
+		return nil
+	}
+
+	if len(args) != len(r.params) {
+		c.errorf(tok, "%v expects %v arguments, got %v", name, len(r.params), len(args))
+	}
+
+	r.sites++
+	if r.sites == maxCallSites+1 {
+		c.errorf(tok, "%v is called from more than %v places, the number of its call site does not fit in 8 bits", name, maxCallSites)
+	}
+
+	if c.current != nil {
+		c.current.calls = append(c.current.calls, call{callee: name, token: tok})
+	}
+	return r
+}
+
+func (c *checker) returnStatement(node *ast.ReturnStatement) {
+	if node.Value != nil {
+		node.Value = c.expression(node.Value)
+	}
+
+	switch {
+	case c.current == nil:
+		c.errorf(node.Token, "return outside of a proc or fn")
+	case c.current.isFn && node.Value == nil:
+		c.errorf(node.Token, "fn %v must return a value", c.current.name)
+	case !c.current.isFn && node.Value != nil:
+		c.errorf(node.Token, "proc %v cannot return a value", c.current.name)
+	}
+}
+
+// declareVariable adds a variable or table declared with @ to the current
+// scope and returns the data label allocated for it.
+func (c *checker) declareVariable(tok *token.Token, name string, kind symbolKind) string {
+	if sym := c.scope.lookup(name); sym != nil && sym.builtin {
+		c.errorf(tok, "%v is a builtin and cannot be declared", name)
+		return ""
+	}
+
+	prefix := "var_"
+	if kind == tableSymbol {
+		prefix = "tab_"
+	}
+
+	sym := &symbol{name: name, kind: kind, token: tok}
+	if previous := c.scope.declare(sym); previous != nil {
+		c.errorf(tok, "%v is already declared at %v:%v", name, previous.token.Pos.Line, previous.token.Pos.Column)
+		return previous.storage
+	}
+
+	sym.storage = c.newStorage(prefix, name)
+	return sym.storage
+}
+
+// newStorage returns an unused data label for a declaration of name. The
+// first declaration of a name gets the readable label prefix+name, later
+// ones and names the assembler does not accept in a label are numbered.
+// Numbers cannot clash with a name since identifiers start with a letter.
+func (c *checker) newStorage(prefix, name string) string {
+	label := prefix + name
+	if !isLabelName(name) || c.labels[label] {
+		label = fmt.Sprintf("%v%v", prefix, len(c.labels))
+	}
+	c.labels[label] = true
+	return label
+}
+
+func isLabelName(name string) bool {
+	for _, r := range name {
+		if !('a' <= r && r <= 'z' || '0' <= r && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// checkUse reports a use of name that does not resolve to a declared symbol
+// of the expected kind, or that reads or writes a builtin the wrong way.
+func (c *checker) checkUse(tok *token.Token, name string, kind symbolKind, write bool) string {
+	sym := c.scope.lookup(name)
+	if sym == nil {
+		c.errorf(tok, "undeclared identifier %v", name)
+		return ""
+	}
+
+	switch {
+	case sym.kind == scalarSymbol && kind == tableSymbol:
+		c.errorf(tok, "%v is not a table and cannot be indexed", name)
+	case sym.kind == tableSymbol && kind == scalarSymbol && write:
+		c.errorf(tok, "cannot assign to table %v as a whole", name)
+	case sym.kind == tableSymbol && kind == scalarSymbol:
+		c.errorf(tok, "table %v must be indexed", name)
+	case write && sym.readOnly:
+		c.errorf(tok, "cannot assign to %v", name)
+	case !write && sym.writeOnly:
+		c.errorf(tok, "%v cannot be read", name)
+	}
+	return sym.storage
+}
+
+// lookupConstant returns the constant named name, unless a parameter of the
+// routine being checked shadows it.
+func (c *checker) lookupConstant(name string) *constant {
+	if c.current != nil && c.current.paramNames[name] {
+		return nil
+	}
+	return c.constants[name]
+}
+
+func (c *checker) checkNotConstant(tok *token.Token, name string) {
+	if c.constants[name] != nil {
+		c.errorf(tok, "%v is already declared as a constant", name)
+	}
+}
+
+func (c *checker) constValue(k *constant) (int, bool) {
+	switch k.state {
+	case resolved:
+		return k.value, true
+	case resolving:
+		c.errorf(k.decl.Token, "constant %v is defined in terms of itself", k.decl.Name)
+		k.state = invalid
+		return 0, false
+	case invalid:
+		return 0, false
+	}
+
+	k.state = resolving
+	value, ok := c.evaluate(k.decl.Value)
+	if k.state == invalid {
+		return 0, false
+	}
+	if ok && value < 0 {
+		c.errorf(k.decl.Token, "constant %v evaluates to the negative value %v", k.decl.Name, value)
+		ok = false
+	}
+	if !ok {
+		k.state = invalid
+		return 0, false
+	}
+
+	k.value, k.state = value, resolved
+	return value, true
+}
+
+// evaluate computes the value of a constant expression. Logical operators
+// follow the code generator and treat a value as true when it equals 1.
+func (c *checker) evaluate(expr ast.Expression) (int, bool) {
+	switch node := expr.(type) {
+	case *ast.IntegerLiteral:
+		value, err := ast.ParseInteger(node.Value)
+		if err != nil {
+			c.errorf(node.Token, "invalid integer literal %v", node.Value)
+			return 0, false
+		}
+		return value, true
+
+	case *ast.Identifier:
+		k := c.lookupConstant(node.Value)
+		if k == nil {
+			c.errorf(node.Token, "%v is not a constant", node.Value)
+			return 0, false
+		}
+		return c.constValue(k)
+
+	case *ast.PrefixExpression:
+		right, ok := c.evaluate(node.Right)
+		return boolValue(right != 1), ok
+
+	case *ast.InfixExpression:
+		left, okLeft := c.evaluate(node.Left)
+		right, okRight := c.evaluate(node.Right)
+		if !okLeft || !okRight {
+			return 0, false
+		}
+
+		switch node.Operator {
+		case "+":
+			return left + right, true
+		case "-":
+			return left - right, true
+		case "*":
+			return left * right, true
+		case "&":
+			return left & right, true
+		case "==":
+			return boolValue(left == right), true
+		case "!=":
+			return boolValue(left != right), true
+		case "<":
+			return boolValue(left < right), true
+		case ">":
+			return boolValue(left > right), true
+		case "<=":
+			return boolValue(left <= right), true
+		case ">=":
+			return boolValue(left >= right), true
+		case "&&":
+			return boolValue(left == 1 && right == 1), true
+		case "||":
+			return boolValue(left == 1 || right == 1), true
+		}
+
+	case *ast.TabExpression:
+		c.errorf(node.Token, "%v[...] is not a constant expression", node.Ident.Value)
+		return 0, false
+
+	case *ast.CallExpression:
+		c.errorf(node.Token, "call to %v is not a constant expression", node.Name)
+		return 0, false
+	}
+	return 0, false
+}
+
+func boolValue(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// checkRecursion rejects every cycle of the call graph. Parameters, results
+// and return addresses live in a single static slot per routine, so a
+// routine must never be called while it is already running.
+func (c *checker) checkRecursion() {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[*routine]int{}
+	var path []*routine
+
+	var visit func(r *routine)
+	visit = func(r *routine) {
+		state[r] = visiting
+		path = append(path, r)
+		for _, call := range r.calls {
+			callee, ok := c.routines[call.callee]
+			if !ok {
+				continue
+			}
+			switch state[callee] {
+			case visiting:
+				c.errorf(call.token, "recursive call to %v is not supported (%v)", callee.name, cycle(path, callee))
+			case unvisited:
+				visit(callee)
+			}
+		}
+		path = path[:len(path)-1]
+		state[r] = visited
+	}
+
+	for _, r := range c.order {
+		if state[r] == unvisited {
+			visit(r)
+		}
+	}
+}
+
+func cycle(path []*routine, to *routine) string {
+	var names []string
+	for i := len(path) - 1; i >= 0; i-- {
+		names = append([]string{path[i].name}, names...)
+		if path[i] == to {
+			break
+		}
+	}
+	return strings.Join(append(names, to.name), " -> ")
+}
@@ -0,0 +1,61 @@
+package semantic
+
+import "minicompiler/token"
+
+type symbolKind int
+
+const (
+	scalarSymbol symbolKind = iota
+	tableSymbol
+)
+
+// symbol is a variable or table visible from a scope, storage is the data
+// label allocated for it. Builtins are the memory mapped devices of the
+// board, they have no declaration token nor storage.
+type symbol struct {
+	name      string
+	kind      symbolKind
+	storage   string
+	token     *token.Token
+	builtin   bool
+	readOnly  bool
+	writeOnly bool
+}
+
+type scope struct {
+	parent  *scope
+	symbols map[string]*symbol
+}
+
+func newScope(parent *scope) *scope {
+	return &scope{parent: parent, symbols: map[string]*symbol{}}
+}
+
+// universe is the outermost scope, holding the builtins.
+func universe() *scope {
+	s := newScope(nil)
+	s.declare(&symbol{name: "input", kind: scalarSymbol, builtin: true, readOnly: true})
+	s.declare(&symbol{name: "random", kind: scalarSymbol, builtin: true, readOnly: true})
+	s.declare(&symbol{name: "output", kind: scalarSymbol, builtin: true, writeOnly: true})
+	s.declare(&symbol{name: "screen", kind: tableSymbol, builtin: true, writeOnly: true})
+	return s
+}
+
+func (s *scope) lookup(name string) *symbol {
+	for ; s != nil; s = s.parent {
+		if sym, ok := s.symbols[name]; ok {
+			return sym
+		}
+	}
+	return nil
+}
+
+// declare adds sym to the scope and returns the symbol of the same name
+// already declared in it, if any.
+func (s *scope) declare(sym *symbol) *symbol {
+	if previous, ok := s.symbols[sym.name]; ok {
+		return previous
+	}
+	s.symbols[sym.name] = sym
+	return nil
+}
@@ -0,0 +1,179 @@
+package sim
+
+import (
+	"fmt"
+	"minicompiler/mif_parser"
+)
+
+// Memory map of the board.
+const (
+	Depth      = 8192
+	ScreenAddr = 0x4000
+	ScreenSize = 15 * 20
+	InputAddr  = 0x8000
+	OutputAddr = 0x8001
+	RandomAddr = 0xC000
+)
+
+// Machine is an execution model of the CPU running a MIF image. R0 and R3
+// are 8-bit data registers and R1 is the 16-bit address register. CMP sets
+// Z when R0 == R3 and C when R0 >= R3 (no borrow), so BCC branches when R0
+// is lower than R3.
+type Machine struct {
+	Mem    [Depth]uint32
+	Screen [ScreenSize]uint8
+
+	R0, R3 uint8
+	R1     uint16
+	PC     uint16
+	Z, C   bool
+
+	// Input and Random provide the values read at InputAddr and
+	// RandomAddr. Every byte stored at OutputAddr is appended to Output.
+	Input  func() uint8
+	Random func() uint8
+	Output []uint8
+
+	// Wait is called by WAIT with the instruction parameter.
+	Wait func(time uint16)
+
+	Steps  int
+	Halted bool
+}
+
+// LoadMif builds a machine whose memory holds the content of a MIF file as
+// written by mif_parser.CompileToMif.
+func LoadMif(mif string) (*Machine, error) {
+	words, err := mif_parser.Words(mif)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) > Depth {
+		return nil, fmt.Errorf("address 0x%X out of memory", len(words)-1)
+	}
+
+	m := &Machine{}
+	copy(m.Mem[:], words)
+	return m, nil
+}
+
+func (m *Machine) load(addr uint16) uint8 {
+	switch {
+	case addr < Depth:
+		return uint8(m.Mem[addr])
+	case addr >= ScreenAddr && addr < ScreenAddr+ScreenSize:
+		return m.Screen[addr-ScreenAddr]
+	case addr == InputAddr && m.Input != nil:
+		return m.Input()
+	case addr == RandomAddr && m.Random != nil:
+		return m.Random()
+	}
+	return 0
+}
+
+func (m *Machine) store(addr uint16, value uint8) {
+	switch {
+	case addr < Depth:
+		m.Mem[addr] = uint32(value)
+	case addr >= ScreenAddr && addr < ScreenAddr+ScreenSize:
+		m.Screen[addr-ScreenAddr] = value
+	case addr == OutputAddr:
+		m.Output = append(m.Output, value)
+	}
+}
+
+// Step executes one instruction. A branch to itself halts the machine, which
+// is how generated programs end (endprog B endprog).
+func (m *Machine) Step() error {
+	if m.Halted {
+		return nil
+	}
+	if m.PC >= Depth {
+		return fmt.Errorf("program counter 0x%X out of memory", m.PC)
+	}
+
+	word := m.Mem[m.PC]
+	op, param := word>>16, uint16(word)
+	next := m.PC + 1
+
+	switch op {
+	case 0x01:
+		m.R1 = param
+	case 0x02:
+		m.R0 = uint8(param)
+	case 0x03:
+		m.R3 = uint8(param)
+	case 0x04:
+		m.R0 = m.load(m.R1)
+	case 0x05:
+		m.R3 = m.load(m.R1)
+	case 0x06:
+		m.store(m.R1, m.R0)
+	case 0x07:
+		m.Z = m.R0 == m.R3
+		m.C = m.R0 >= m.R3
+	case 0x08:
+		if m.Z {
+			next = param
+		}
+	case 0x09:
+		m.R1 += uint16(m.R0)
+	case 0x0A:
+		if !m.C {
+			next = param
+		}
+	case 0x0B:
+		if param == m.PC {
+			m.Halted = true
+		}
+		next = param
+	case 0x0C:
+		m.R3 = m.load(uint16(m.R3))
+	case 0x0D:
+		m.R0 += uint8(param)
+	case 0x0E:
+		m.R0 -= m.R3
+	case 0x0F:
+		m.R0 &= m.R3
+	case 0x10:
+		m.R0 >>= m.R3
+	case 0x11:
+		m.R0 += m.R3
+	case 0x12:
+		m.R0 += m.R0
+	case 0x13:
+		m.R3 = m.R0
+	case 0x14:
+		if !m.Z {
+			next = param
+		}
+	case 0x15:
+		if m.Wait != nil {
+			m.Wait(param)
+		}
+	case 0x16:
+		m.R0 *= m.R3
+	case 0x17:
+		m.R0 = m.R3
+	default:
+		return fmt.Errorf("invalid opcode 0x%02X at 0x%X", op, m.PC)
+	}
+
+	m.PC = next
+	m.Steps++
+	return nil
+}
+
+// Run executes instructions until the machine halts. It fails when the
+// program does not halt within maxSteps instructions.
+func (m *Machine) Run(maxSteps int) error {
+	for !m.Halted {
+		if m.Steps >= maxSteps {
+			return fmt.Errorf("program did not halt after %v steps", maxSteps)
+		}
+		if err := m.Step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -139,55 +139,91 @@ var TokMap = TokenMap{
 		"INVALID",
 		"$",
 		"empty",
-		"lbrace",
-		"rbrace",
-		"@",
+		"proc",
 		"identifier",
+		"fn",
+		"(",
+		")",
+		"const",
 		"assign",
 		"terminator",
+		",",
+		"lbrace",
+		"rbrace",
+		"@",
 		"[",
-		"intLit",
 		"]",
-		"if",
+		"match",
 		"while",
 		"wait",
-		"(",
-		")",
+		"for",
+		"break",
+		"continue",
+		"return",
+		"error",
+		"if",
 		"else",
+		"=>",
+		"_",
+		"||",
+		"&&",
+		"!",
+		"and",
+		"mul",
+		"intLit",
 		"plus",
 		"minus",
-		"mul",
-		"and",
 		"==",
 		"!=",
 		"<",
+		">",
+		"<=",
+		">=",
 	},
 
 	idMap: map[string]Type{
 		"INVALID":    0,
 		"$":          1,
 		"empty":      2,
-		"lbrace":     3,
-		"rbrace":     4,
-		"@":          5,
-		"identifier": 6,
-		"assign":     7,
-		"terminator": 8,
-		"[":          9,
-		"intLit":     10,
-		"]":          11,
-		"if":         12,
-		"while":      13,
-		"wait":       14,
-		"(":          15,
-		")":          16,
-		"else":       17,
-		"plus":       18,
-		"minus":      19,
-		"mul":        20,
-		"and":        21,
-		"==":         22,
-		"!=":         23,
-		"<":          24,
+		"proc":       3,
+		"identifier": 4,
+		"fn":         5,
+		"(":          6,
+		")":          7,
+		"const":      8,
+		"assign":     9,
+		"terminator": 10,
+		",":          11,
+		"lbrace":     12,
+		"rbrace":     13,
+		"@":          14,
+		"[":          15,
+		"]":          16,
+		"match":      17,
+		"while":      18,
+		"wait":       19,
+		"for":        20,
+		"break":      21,
+		"continue":   22,
+		"return":     23,
+		"error":      24,
+		"if":         25,
+		"else":       26,
+		"=>":         27,
+		"_":          28,
+		"||":         29,
+		"&&":         30,
+		"!":          31,
+		"and":        32,
+		"mul":        33,
+		"intLit":     34,
+		"plus":       35,
+		"minus":      36,
+		"==":         37,
+		"!=":         38,
+		"<":          39,
+		">":          40,
+		"<=":         41,
+		">=":         42,
 	},
 }